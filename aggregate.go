@@ -0,0 +1,289 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/internal/batch"
+)
+
+var (
+	// ErrUnsupportedMessage 不支持的消息类型
+	ErrUnsupportedMessage = errors.New("work_weixin_robot: unsupported message type")
+	// ErrAggregated 消息已加入汇总，将在窗口结束或达到数量时发送
+	ErrAggregated = fmt.Errorf("%w: aggregated", ErrAccepted)
+)
+
+// AggregatorOption Aggregator 配置项
+type AggregatorOption func(*Aggregator)
+
+// WithAggregatorMaxCount 累积 count 条消息时立即发送，默认 50
+func WithAggregatorMaxCount(count int) AggregatorOption {
+	return func(aggregator *Aggregator) {
+		aggregator.maxCount = count
+	}
+}
+
+// WithAggregatorTitle 汇总消息标题
+func WithAggregatorTitle(title string) AggregatorOption {
+	return func(aggregator *Aggregator) {
+		aggregator.title = title
+	}
+}
+
+// WithAggregatorClock 时钟，默认 SystemClock
+func WithAggregatorClock(clock Clock) AggregatorOption {
+	return func(aggregator *Aggregator) {
+		aggregator.clock = clock
+	}
+}
+
+// WithAggregatorMetrics 记录待发送消息数量
+func WithAggregatorMetrics(recorder MetricsRecorder) AggregatorOption {
+	return func(aggregator *Aggregator) {
+		aggregator.metrics = recorder
+	}
+}
+
+// WithAggregatorErrorHandler 定时发送失败时的回调
+func WithAggregatorErrorHandler(handler func(error)) AggregatorOption {
+	return func(aggregator *Aggregator) {
+		aggregator.onError = handler
+	}
+}
+
+// digest 某个 webhook 待汇总的消息
+type digest struct {
+	contents []string
+	userIds  []string
+	mobiles  []string
+	timer    Timer
+}
+
+// Aggregator 按 webhook 汇总 TextMessage、MarkdownMessage，窗口结束或达到数量时以 markdown 消息发送
+type Aggregator struct {
+	sender   Sender
+	window   time.Duration
+	maxCount int
+	title    string
+	clock    Clock
+	metrics  MetricsRecorder
+	onError  func(error)
+
+	mu      sync.Mutex
+	digests map[string]*digest
+}
+
+// NewAggregator create Aggregator, 汇总消息通过 sender 发送
+func NewAggregator(sender Sender, window time.Duration, opts ...AggregatorOption) *Aggregator {
+	aggregator := &Aggregator{
+		sender:   sender,
+		window:   window,
+		maxCount: 50,
+		clock:    SystemClock,
+		onError:  func(error) {},
+		digests:  map[string]*digest{},
+	}
+	for _, opt := range opts {
+		opt(aggregator)
+	}
+	return aggregator
+}
+
+// Add 汇总消息，发送到 sender 的默认地址
+func (aggregator *Aggregator) Add(message Message) error {
+	return aggregator.AddByUrl("", message)
+}
+
+// Send Sender, 汇总 TextMessage、MarkdownMessage 并返回 ErrAggregated，其他消息直接发送
+func (aggregator *Aggregator) Send(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+	switch message.(type) {
+	case *TextMessage, *MarkdownMessage:
+	default:
+		return aggregator.sender.Send(ctx, url, message)
+	}
+	if err := aggregator.AddByUrl(url, message); err != nil {
+		return nil, err
+	}
+	return nil, ErrAggregated
+}
+
+func (aggregator *Aggregator) defaultWebhook() string {
+	return defaultWebhook(aggregator.sender)
+}
+
+// AddByUrl 汇总消息，仅支持 TextMessage、MarkdownMessage，达到数量时立即发送，url 为空时为 sender 的默认地址
+func (aggregator *Aggregator) AddByUrl(url string, message Message) error {
+	if url == "" {
+		url = defaultWebhook(aggregator.sender)
+	}
+	var content string
+	var userIds, mobiles []string
+	switch m := message.(type) {
+	case *TextMessage:
+		content, userIds, mobiles = m.Content, m.UserIds, m.Mobiles
+	case *MarkdownMessage:
+		content = m.Content
+	default:
+		return ErrUnsupportedMessage
+	}
+	aggregator.mu.Lock()
+	d, ok := aggregator.digests[url]
+	if !ok {
+		d = &digest{}
+		d.timer = aggregator.clock.AfterFunc(aggregator.window, func() {
+			if err := aggregator.flush(context.Background(), url, d); err != nil {
+				aggregator.onError(err)
+			}
+		})
+		aggregator.digests[url] = d
+	}
+	d.contents = append(d.contents, content)
+	d.userIds = appendUnique(d.userIds, userIds...)
+	d.mobiles = appendUnique(d.mobiles, mobiles...)
+	full := len(d.contents) >= aggregator.maxCount
+	aggregator.setQueueDepth(url, len(d.contents))
+	aggregator.mu.Unlock()
+	if full {
+		d.timer.Stop()
+		return aggregator.flush(context.Background(), url, d)
+	}
+	return nil
+}
+
+// Flush 立即发送所有汇总消息
+func (aggregator *Aggregator) Flush(ctx context.Context) error {
+	aggregator.mu.Lock()
+	digests := make(map[string]*digest, len(aggregator.digests))
+	for url, d := range aggregator.digests {
+		digests[url] = d
+	}
+	aggregator.mu.Unlock()
+	var errs []error
+	for url, d := range digests {
+		d.timer.Stop()
+		if err := aggregator.flush(ctx, url, d); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return joinErrors(errs)
+}
+
+// flush 发送 webhook 的汇总消息，某条失败时仍发送其余的消息，返回合并的错误
+func (aggregator *Aggregator) flush(ctx context.Context, url string, d *digest) error {
+	aggregator.mu.Lock()
+	if aggregator.digests[url] != d {
+		aggregator.mu.Unlock()
+		return nil
+	}
+	delete(aggregator.digests, url)
+	aggregator.setQueueDepth(url, 0)
+	aggregator.mu.Unlock()
+	var errs []error
+	for _, message := range d.messages(aggregator.title) {
+		res, err := aggregator.sender.Send(ctx, url, message)
+		if err == nil && res != nil && !res.IsSuccess() {
+			err = fmt.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+		}
+		if err != nil && !notSent(err) {
+			errs = append(errs, err)
+		}
+	}
+	return joinErrors(errs)
+}
+
+// multiError 多个错误
+type multiError []error
+
+func (errs multiError) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap errors.Is、errors.As 逐个匹配
+func (errs multiError) Unwrap() []error {
+	return errs
+}
+
+// joinErrors 合并错误，没有错误时返回 nil
+func joinErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return multiError(errs)
+	}
+}
+
+func (aggregator *Aggregator) setQueueDepth(url string, depth int) {
+	if aggregator.metrics != nil {
+		aggregator.metrics.SetQueueDepth(RedactWebhook(url), depth)
+	}
+}
+
+// messages 汇总为不超过 MarkdownMaxBytes 的 markdown 消息，@成员在最后一条消息中，
+// 手机号无法在 markdown 中提醒，以一条 TextMessage 发送
+func (d *digest) messages(title string) []Message {
+	// 标题最多占 1/4，@成员最多占 1/2，超出的成员不再提醒
+	var header string
+	if title != "" {
+		header = "**" + batch.TruncateBytes(title, MarkdownMaxBytes/4-len("****\n")) + "**\n"
+	}
+	var mentions strings.Builder
+	var userIds []string
+	for _, userId := range d.userIds {
+		tag := mentionTag(userId)
+		if mentions.Len()+len(tag) > MarkdownMaxBytes/2 {
+			continue
+		}
+		mentions.WriteString(tag)
+		userIds = append(userIds, userId)
+	}
+	limit := MarkdownMaxBytes - mentions.Len() - 1
+	var messages []Message
+	var builder strings.Builder
+	builder.WriteString(header)
+	for i, content := range d.contents {
+		content = batch.TruncateBytes(content, limit-len(header))
+		if builder.Len() > len(header) && builder.Len()+1+len(content) > limit {
+			messages = append(messages, NewMarkdownMessage(strings.TrimSuffix(builder.String(), "\n")))
+			builder.Reset()
+			builder.WriteString(header)
+		}
+		builder.WriteString(content)
+		if i < len(d.contents)-1 {
+			builder.WriteString("\n")
+		}
+	}
+	messages = append(messages, NewMarkdownMessage(builder.String()).AddMention(userIds...))
+	if len(d.mobiles) > 0 {
+		messages = append(messages, NewTextMessage(fmt.Sprintf("%d messages aggregated", len(d.contents))).SetMobiles(d.mobiles...))
+	}
+	return messages
+}
+
+// appendUnique 追加不重复的元素
+func appendUnique(values []string, elems ...string) []string {
+	for _, elem := range elems {
+		exists := false
+		for _, value := range values {
+			if value == elem {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			values = append(values, elem)
+		}
+	}
+	return values
+}
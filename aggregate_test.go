@@ -0,0 +1,158 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestAggregator(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	metrics := NewPrometheusMetrics("")
+	aggregator := NewAggregator(NewRobotClientByWebHook(server.Webhook()), time.Minute,
+		WithAggregatorClock(clock), WithAggregatorTitle("deploy"), WithAggregatorMetrics(metrics))
+
+	_ = aggregator.Add(NewTextMessage("api deployed").SetUserIds("zhangsan"))
+	_ = aggregator.Add(NewMarkdownMessage("web <font color=\"info\">deployed</font>"))
+	_ = aggregator.Add(NewTextMessage("worker deployed").SetUserIds("zhangsan", "lisi").SetMobiles("13800001111"))
+	if err := aggregator.Add(NewFileMessage("3a8asd892asd8asd")); err != ErrUnsupportedMessage {
+		t.Errorf("err = %v", err)
+	}
+	if len(server.Messages()) != 0 {
+		t.Fatal("sent before window end")
+	}
+	if sent := metrics.queue[labels("webhook", RedactWebhook(server.Webhook()))]; sent != 3 {
+		t.Errorf("queue depth = %v", sent)
+	}
+	clock.Advance(time.Minute)
+
+	received := server.Messages()
+	if len(received) != 2 {
+		t.Fatalf("received = %v", received)
+	}
+	want := "**deploy**\napi deployed\nweb <font color=\"info\">deployed</font>\nworker deployed\n<@zhangsan><@lisi>"
+	if content := received[0]["markdown"].(map[string]interface{})["content"]; content != want {
+		t.Errorf("content = %q, want %q", content, want)
+	}
+	text := received[1]["text"].(map[string]interface{})
+	if !reflect.DeepEqual(text["mentioned_mobile_list"], []interface{}{"13800001111"}) {
+		t.Errorf("text = %v", text)
+	}
+	if sent := metrics.queue[labels("webhook", RedactWebhook(server.Webhook()))]; sent != 0 {
+		t.Errorf("queue depth = %v", sent)
+	}
+}
+
+func TestAggregator_MaxCount(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	aggregator := NewAggregator(NewRobotClientByWebHook(server.Webhook()), time.Minute,
+		WithAggregatorClock(clock), WithAggregatorMaxCount(2))
+
+	for _, content := range []string{"a", "b", "c"} {
+		if err := aggregator.Add(NewTextMessage(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"a\nb"}) {
+		t.Errorf("contents = %q", contents)
+	}
+	if err := aggregator.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(time.Minute)
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"a\nb", "c"}) {
+		t.Errorf("contents = %q", contents)
+	}
+}
+
+func TestAggregator_ChunkFailed(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	server.Enqueue(robottest.Response{}, robottest.Response{ErrCode: robottest.RateLimitErrCode})
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	var errs []error
+	aggregator := NewAggregator(NewRobotClientByWebHook(server.Webhook()), time.Minute, WithAggregatorClock(clock),
+		WithAggregatorErrorHandler(func(err error) { errs = append(errs, err) }))
+
+	for _, content := range []string{"a", "b", "c"} {
+		_ = aggregator.Add(NewTextMessage(strings.Repeat(content, 3000)).SetUserIds("zhangsan"))
+	}
+	clock.Advance(time.Minute)
+
+	if requests := server.Requests(); len(requests) != 3 {
+		t.Fatalf("requests = %d", len(requests))
+	}
+	messages := server.Messages()
+	if content := messages[len(messages)-1]["markdown"].(map[string]interface{})["content"].(string); !strings.HasSuffix(content, "<@zhangsan>") {
+		t.Errorf("last content = %q", content)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "45009") {
+		t.Errorf("errs = %v", errs)
+	}
+}
+
+func TestAggregator_SizeLimit(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	aggregator := NewAggregator(NewRobotClientByWebHook(server.Webhook()), time.Minute, WithAggregatorClock(clock))
+
+	line := strings.Repeat("日志", 300)
+	for i := 0; i < 5; i++ {
+		_ = aggregator.Add(NewTextMessage(line).SetUserIds("zhangsan"))
+	}
+	_ = aggregator.Add(NewTextMessage(strings.Repeat("x", 5000)))
+	clock.Advance(time.Minute)
+
+	contents := server.Contents()
+	if len(contents) < 2 {
+		t.Fatalf("contents = %d", len(contents))
+	}
+	total := 0
+	for _, content := range contents {
+		if len(content) > MarkdownMaxBytes {
+			t.Errorf("content size = %d", len(content))
+		}
+		total += strings.Count(content, line)
+	}
+	if total != 5 || !strings.HasSuffix(contents[len(contents)-1], "<@zhangsan>") {
+		t.Errorf("unexpected contents: %d lines", total)
+	}
+}
+
+func TestAggregator_ManyMentions(t *testing.T) {
+	recorder := NewRecordingSender()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	aggregator := NewAggregator(recorder, time.Minute, WithAggregatorClock(clock), WithAggregatorMaxCount(1000), WithAggregatorTitle(strings.Repeat("告警", 1000)))
+
+	for i := 0; i < 400; i++ {
+		_ = aggregator.Add(NewTextMessage(strings.Repeat("x", 100)).SetUserIds(fmt.Sprintf("user%06d", i)))
+	}
+	if err := aggregator.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	recordings := recorder.Recordings()
+	if len(recordings) < 2 {
+		t.Fatalf("recordings = %d", len(recordings))
+	}
+	for _, recording := range recordings {
+		content := recording.Message.ToMessageMap()["markdown"].(map[string]interface{})["content"].(string)
+		if len(content) > MarkdownMaxBytes || !utf8.ValidString(content) {
+			t.Errorf("content size = %d", len(content))
+		}
+	}
+	last := recordings[len(recordings)-1].Message.ToMessageMap()["markdown"].(map[string]interface{})["content"].(string)
+	if !strings.Contains(last, "<@user000000>") {
+		t.Error("missing mentions")
+	}
+}
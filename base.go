@@ -1,5 +1,11 @@
 package work_weixin_robot
 
+import (
+	"strings"
+
+	"github.com/group-robot/work-weixin-robot/robotschema"
+)
+
 // MsgType  消息类型
 type MsgType string
 
@@ -18,6 +24,13 @@ const (
 	TemplateCardMsgType MsgType = "template_card"
 )
 
+const (
+	// TextMaxBytes 文本内容最长字节数
+	TextMaxBytes = robotschema.TextMaxBytes
+	// MarkdownMaxBytes markdown内容最长字节数
+	MarkdownMaxBytes = robotschema.MarkdownMaxBytes
+)
+
 // Message base message struct
 type Message interface {
 	// ToMessageMap for JSON  serialization
@@ -100,6 +113,8 @@ func (message *TextMessage) ToMessageMap() map[string]interface{} {
 type MarkdownMessage struct {
 	// Content markdown内容，最长不超过4096个字节
 	Content string
+	// Mentions @成员的userid列表，以 <@userid> 追加到内容末尾
+	Mentions []string
 }
 
 // NewMarkdownMessage create MarkdownMessage
@@ -109,13 +124,51 @@ func NewMarkdownMessage(content string) *MarkdownMessage {
 	}
 }
 
+// AddMention add MarkdownMessage.Mentions, 忽略重复及内容中已存在的 <@userid>
+func (message *MarkdownMessage) AddMention(userIds ...string) *MarkdownMessage {
+	for _, userId := range userIds {
+		if userId == "" || strings.Contains(message.Content, mentionTag(userId)) {
+			continue
+		}
+		exists := false
+		for _, mention := range message.Mentions {
+			if mention == userId {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			message.Mentions = append(message.Mentions, userId)
+		}
+	}
+	return message
+}
+
+// MentionAll @所有人
+func (message *MarkdownMessage) MentionAll() *MarkdownMessage {
+	return message.AddMention(MentionAllUserId)
+}
+
+// mentionTag markdown @成员，@all 为 <@all>
+func mentionTag(userId string) string {
+	return "<@" + strings.TrimPrefix(userId, "@") + ">"
+}
+
 func (message *MarkdownMessage) GetMsgType() MsgType {
 	return MarkdownMsgType
 }
 
 func (message *MarkdownMessage) ToMessageMap() map[string]interface{} {
+	text := message.Content
+	if len(message.Mentions) > 0 {
+		var tags strings.Builder
+		for _, userId := range message.Mentions {
+			tags.WriteString(mentionTag(userId))
+		}
+		text += "\n" + tags.String()
+	}
 	content := map[string]interface{}{
-		"content": message.Content,
+		"content": text,
 	}
 	return map[string]interface{}{
 		"msgtype":  message.GetMsgType(),
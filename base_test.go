@@ -1,14 +1,14 @@
 package work_weixin_robot
 
 import (
-	"os"
+	"reflect"
 	"testing"
 )
 
 func TestNewTextMessage(t *testing.T) {
 	message := NewTextMessage("测试 golang")
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -23,7 +23,7 @@ func TestNewTextMessage(t *testing.T) {
 func TestNewTextMessageAtAll(t *testing.T) {
 	message := NewTextMessageAtAll("测试 golang")
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -41,7 +41,7 @@ func TestNewMarkdownMessage(t *testing.T) {
          >普通用户反馈:<font color=\"comment\">117例</font>
          >VIP用户反馈:<font color=\"comment\">15例</font>`)
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -60,7 +60,7 @@ func TestNewMarkdownMessageAtAll(t *testing.T) {
 
 		 <@all> `)
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -75,7 +75,7 @@ func TestNewMarkdownMessageAtAll(t *testing.T) {
 func TestNewImageMessage(t *testing.T) {
 	message := NewImageMessage("iVBORw0KGgoAAAANSUhEUgAAAtAAAAGQCAMAAACwOadfAAADAFBMVEXx5dn+/e//++ru2LP+/vr//Oj+/fZ4SiD/++3//v3+++b++OT//ur//PLx4tD+//H++Orz5dX9/P79/Prv3L/u2rry6Ofv49UVAgPx4Mv06+z8+fbw3sXs2bfv49j79fIGAQZ4Iqf38/sfAwr+9+9tIKDFgWwdF2n5+Pv68dzCiHPx59/269jz7ff89d8EDBb48vX58e8jL4Svk/GuYLv27OGuaciydtGtier07PK7gWt/Ka+1nvWENrj68eby4uTPqK67hnEfJXWsVK6gWkW1ZlCgZc+3bMLUsLSVSbzLoKbBemQrPpS4X62neeCPMq4qBwu8f8+1cFwxFBS6Z7ecNa7AdLakcNU9XbXBc1y7qfW8dsahQ7CWVcjix8SsZFBhGn6lgefGl58oIVLYt7vCsvUzSKiHK6u2eWQuKGDdwMC7bFbLi3OlYMWUPLO4WaFGa8BQLixfI6RbHJa1les0TZtCJSUUBBWhUry/baY9H2moXUmxQnnJkLutTKC3jOEtLZOySo7EhKyWVUChke/DjpfSqLxpRDxdOTKxZmvEhL8qETrn09OVLWSCScX779SpNFnJvfju3+Csa1iMZl67dX7LmrPs2NqcM3i/ho7ly82yPmRAWqa/fIZXeMmNkOe5bXaOJk3OrZDUuZ7awq8kCSSiYkykO42AXlaqWF5uHYl4jNz46cw7FkhzU01EK59NHXabb2JngtNQQK9BERB3ST28WnmiN6TQnsGGUEKodmaxh3DQyPdsM7TEks+8YY/Np9W+oOmgKUqWcEt9UCe6UGlTLl+QWkuYLqSEWDHq1a68noGfRU9lRbzRmoCQVKQ+ETWaYlSBSZmCOIhJG1D+rwCMncxYcLHHv+LNfmTBeZqjsN+MYz1tQ4qqp+/i0Lx2iL2oh2Z4KD+gfFiVa7deEQthOHb7mAD+wgCmrMr91gFcJUPg2vN6Igj96wGZd4V+XHWheJ6bQwq2Zwf1fABuY7L9+LPUiw2fGhKwmqviWwH774biqwr77lfnuHD89CStzv0jAAAgAElEQVR42syazWobaRaGqwqpkOSSVVKBC7sGGToCy1SN2zFoq4XWWhh8CVrVshfqm3BWWXubXc8u1zBXMMiBweBVwBDonkUW2c15zznfT5XlQBK66VM/Kv0mkEeP3nO+BIVW5pc+1un0+1nfFD0eUxVxR8o+09FH4kZ1YnrDhIrfhkt8QNbJhkU8bJY+b4pe18FLwshUTypqVa+X0j5wlZqXpr007fmVpumA94hO3ShqPus+PD1YLg9ou1gulxcLV1VVLbAvzA1O+6osF2VVlgE21DT4pppO86mpnN87Ho9z/xVjW8GY7+Tm/uEh7aaOj90VrnMq85FBSX/Lxevl61NTc/xzy2kyefvh6enL7stu98j1+fFeavfp03/v76S2sj2rNW3bta0rs3m1wk5bs86wtWuj+2azuX336vrV9ftf3r+73Wjdvru+vrlx97kCCzQhPcn6vAHoGIdAax5lMjtFBoBjBnpCGz0fg8DsGdDAFB8ax0MA22e0GdUiDOk8awHd142BLgjonrAcOea6TaAJUwB9cKA8D6KUQU65PFpDwpg3vYnSdC/Q3VSIBs7LxfmC9nMiF4fslbkx10JxtSjxSLCoSmzBuKwMod8INHPXAHrqAT0OghwYyz6W82HOm9I85u3w8I0h+h9vjt/kx/K5+MjpFEQvjpavBeiiOAXMcz7Rv8P85APz/PiFtv8R0ffM9MPnx6fdZ2L53hG9F2qADLANzi2qa+XZMn22qlc1Tquz2iIMihtXm1sC+hUBfUMA17wB6PfvG0DfKtAZK9qzdAyos7jDKFtBZ0Qx6zgG0n2medLHQ4R+3AYaL2PdDwlf/gnAd4QUPypGw7AIC2F6NiTeGXhif8Jci6HD8DlzAw/ngZLLLC9xYq5JwhE/HDUMPYgGBmoYutv84DDt8ZcFPIugL5bnAus5s1vuN3TJ9i5xUYmayctlUJWO0OBbFb3X0BXvqApU426OzYDtLK1UHxqej/Nj8J6bbwr/eDDQJ0T0a0F6fko00796PO/M5/8kQ8PROxU0Ec2G/nz/oDTfPeDYMtYPgPijHGtRND0OqK+2wHl9tTU0r2vaLNS1HMI0K7puWlpI/pmu6GCgrxVo8FyztN+/aGiBeqIuBrAFAdl3hjZcS7bQyMFIS95oGzqWDEIfq0DjDyGQh6NiGJOii5lR9MhFjj5jLYYOCzJrD1hHImlEkLQZOUIidznoauJYCtf0KrrQ9BGyn8NeNw0HYURHknZ7SZL0Gv7u4deAaE4NzZw3zgXgslLxtjZzseAz3YidK5/N4HtKY8ceQ8uHy59QgeXcJQ4HtGItSOfHhHT+RoAWPUPQi6PFUoEuLmmDorN5TIljXnDk2JGgdxD07+D5dxB9x8fdw70o2nraK6EaQINnPgNoorreXjHYBLUJHis/eDDNq81KnYxdT0B64wPNONebfxHQNzc3t76gGehTNbTUJBOgOUYYlDOHNQvZGnqiiO8xtN4SxRQxhsA5HvJOfiZBh+FMDT2MJZ30FerMGDqc8RYykrT1WNCpYJ12JRsvBxZnrYg9nVAi4bcxsTB6hKgRpUlKOCdN99PryNwHqcYNTRsqZHAaWIbtzqJDVXJiLZtDftm/s17K0GO1P04k6jEhXYmhK7PbIJ3bNJ0fM83i6CAXohdq6FMgzfF5Ppp3MjoR0Z9E0JqhraH/YJyNpJVpOHpP8FjzAYxZ0Yoza9oo2vq5Fppx8pP0ant1Zrl2GZojB3hmoPcY+tRTNBOt3R/hLIZWjrn94+ihkcMYWlJIC2htEUfDmFgG1HQKETZoQ9wIuXos6ZECnbGfXYYeEokgWrCm0IvEMaBoIMGDM0VIlA66XcH5go4kwb2UHku4NwTTM6Ka35OkEaJHlwwdtRQNPSM/Ly8uFhcLjhvnKuGgqgTTKuBAoTvfZdYDnEp5hVb5A0BPLX+AmByc28RRyRVfjyV0VONWHbYKmeMNQseU20ImGpFjcYKukKC+JAaIZGQOJA7qCp++SFuIFO0jzQWU/5DUIeet4fljE2duENnQW0kezeaw1RiKodXRYLher0ygPrv1Ise/mebNc6BvTeQQQ/dpY5oLydAcIzRqZC540B5LVyjPTVwKaQiavxRo+PjLgoswLmRTQTNJMHRhFD3hyMPTEBiausJZKKEByHF7SGk3Cl2WxtCCquspukvAc5CWwAGio1CUHkHb+Ayv0bRDEXL9wTJF2jCDDYGZVaz4BoFEChMsqsAIufT1Gvxg2RSthp428rghOx/jVAVm5CGuPnQpGpZG3Di2fpYqechxdHJyYtrCyznmHPM5RY55ZzL5hBC9e9o97to0i6GdoyV02CjdnHZI3lCkwTN72rSGtR+iV76qVcsK889ysSF6rxno/9QSol/M0Gpm3JIeJ2aGF+toLjN5w+TpjvDsnsLRycw0T0d4MuMjKgVuHWYAW7ogjMMZZm5DGXmERWybUa4OfE4BeqYGJQYZbbAJQm0lA68tXNrhnZ1hJKYI/Sjh7NzqNTHvYJyxS95gpNHm8fDNDiyaVe4nUcn7car3fTrf5Hq2TSL/LSsN1UGeC86/SfBgknlqN/WBPioJaArREDT9Rl9eXvLMDixMJicfnoyhLdJNqGXY8eDP8Gzs+CgnGeBtETpskNYOkcP0tjG9q6U1lMjxfH5HRL+7dhm6fgloTDkyndkB6YmOoRUqM4fOGq1hJsg6eWcyugPTdldDxyMmOrZA47LgoME08RWIlswhw0FwzYZWhPkgEhOr3AEFYTU0dOxPogddTSPi9iQSnimo0BOtZtAbUqeCs/Uzt3mVT29rD4QjCSKVCvxPoLhBdPNrxL8fcsPhOkCoRpOogzsYmgV9aAOH4syJg4kmPwNo6Qrnc26iJjLm2CFFO0c/Wpw9pE2U3u4ZTHPeqLeeonnYobFje7feO5FG5jjzHG2mHSZD/6pNYb352pRDKeZRNM/rMjPIyFze6Pe9izj2DA1tZ3FT0ZxCKB+PeCoHPNEPWp4FI44cPMFje/cz/tbIqyiZsD5DbeBCK+geZeFBIkwndN/vCDGrQOvHdhZF020U8qpKxNE5TPYY+gA8Hxg96/zN4FOasZlGWT0q40cr7D8XaDY0sJXZXWWSvLLNoVoaQ+y/jaHlQ9cPwtClIE0/P2xoOBpAX85haAw6Yhj60hjaRGgiumHo+2bu2Lpxx12jLeS6copWQa+1OVxdNQbSImjc7JO0jRxi6JfHdpkuq0j04DMWA2V24RTsMnSmE+m+P/7Q8TS+B5nN0TypCL0VQcTlofLMqyb8MBJ1gTe6MTiHlTAy+YCgxh0609uow0sHYZoMpDNEo+jGHG5ErZGDiSaQsWrygqGtn13cKMt2svCwLZ8FjvI7h87fbujGnUqbRPkLUagG1WaMh2bSLzX0W4nQFDqOTmRthSNHoYaG0yY/saGfnKEZ50fF2dDcTNIapp8RvZYsreuGZoFFRneG59rXNDt5debN7ThIb8TQv+rYbqOGfr5SmOmAWNq1QtYIOSTHew3NQ7vGeDrjoYedcnhr4GFoF0fMIspwZnhOuhFHDhg61HytS5L0AUV4Ku+NzCJ2mCjcoSTjFDZGNE5IyIPEGFoSRs/L0ObQq2Yz6PF8YAOHGcBpZ8YbX5hz4D1hnwz+gnJ/EOs6x0jETj9cRBKqGzSzoN+axEEZujxaSOa4vPyJMwcy9JwjB8/tCGg3umsaWpHeZ+lm7Fh7q+Gt3LH2Zh12Il2/vBCOKce1Axr1FUODYTkNC7MWLUG5naGzvo0iztBxP94DNNauDdEhh2Wz1j1j0ro85BBDF7FvaBa0mYPwsojt5CQvAE3TFaIFtIMOShUiZd/QYmm5bM+fnZ45b3DZlDz9SyD9/hpjHwd2DKNx2iwe2vUZr0pbR9IVEtC66u0ytB1zOEXf37eJFprv77xFlj0LLWbaIZZWnl2Srpno7Ro8q6Q3wvLGdzQDfQ2geam7/tpKYaZEM9BIBQRioUDDma0pR7/jJhxmQC3/VSnOzLwu095vFIaJEaaAPOyRnIdqzshwjh8Ificvfcv7R+gUU6x34JQ4PkMzu8BEmgxt/o+GVFf/wKhlaFdu9tyThUaZbwwkcLCemY+y/HsDPXVLhhI9Sp1Pj+030a2hG0NThkba4KbQM/TcLEX8n5rzd21jz6K4Z5CELY+Q1wIvWKDAShAFPRZZ4OI1fuBahcHN1KtF4E4u5LTbxeBOpFkQ2soQMDasyR+wlevwCHbAvCXFElgSiFO84nX7vefe+/0xM/bCNhvNTGTHjp0Ux5+ce+69327DaFrGk8Ky0El67rlo33UE1WHRzJLrG4aM3icnEpSGz8V7BH3wswNnOZ700PAbTfYa5HKjGvmPirRLOHhQ56xxni0G8UDieUFjGCOJGZg1oL9jZCT+uVwWJ0KCZkJLZtdEQwaEpkSDZB0kbWw5VtEuLMeq53K5XJVkLmM5AknHhfkGDPQLsc8uXF75zgntpXhSo6rr+B165rOWPJAz1YQ8xgFRC6F3mNCmLqwLobvdza0P1nIU5HbWccxzjcNCPVtKC6FDSA+QRWeG78hEj7zicMSEbnuWY+RZjiNf0KRfS2eWVoXH6fTFGIqmdA45UpPIrqQ1o/kQpo6gTn6hKMOCkK1FhRkNkfEwXEQ6bzqnQn9BpcEZH9wKRjkCOauPNvA3v6jOg482Tno1J2T6S+KkyGmIf95Y7Xh53R7nG27wcxnknPtXevWpYNmzHDPJ7Cjk2N3a2tve29vpm6vLF3toQ+gfreUwhIakF07TqZd0pPM0C+nHGD3khzA9zEM6KAv3CwJpIbSdtvMJfTQKBd3BrCjpkBrTVtAi6QpZZIw0001piB2FFs+B2bwKVKyabtZU0Pxfe03MDApC0lqZS71atleumXVN7XccxWErJOECMSFJI3TGcEacKfkgaMPsWAhdJOiNDT/feLEred3/PCj3f7Ad0sHprbh+fM8miExlKglncvdmgYdGbLfT7/a74qEp46AJUhtE/+Ycx8fb+493C3ND0kbN6V2a5jWd1fMwMyU98Iy0k3NRHv2cHw0+AkEfOUG/uzkKBQ3DQYTGXCe7WYnt7OS+9gFBaPbQa5trJSkYSxrVCZ/lDUsSVZ/GdxVuqKCiU8vR9PrlUL1HaG2PZK9EEL3K7RVOmLOE5v74k4Sma135DED3vH72UhBa/pUuMe9xPu0IHdaEMyE0dVYkh+4b02FETQYaJnqz0WUP7Uz0wtz3/7wFoSeQNLRsVG30bEyHVfW0gNKkafMDwIB2paEbK1VCB5B+rpSeDvddUXh8fHLJFloF/f7hk+85yEN32EfTLYFDQGhRNkZK0YCR2X5yz1wdkqDpJwFg7kig0cH4Ecd0NQqSI6gXiC7T5ggL2lMzQ5z0bwOSRJ/womZhRC1wHjaKMT9nJF0OBE2DHk8QmjsqLn/eg+FwKfPyWA5vmsSbxPYIbR2HJfQWe2gQut8VRhOhG10bRN+GRSHdiwXpGYROPVXDSac5z+FJmgQ99aZJM1ssOqo0yGywcKvFI/Tp6ef3omdH6KAoRAnYwNh9BVmHR2jt/VW0D8iTdhU7Cg1Cu0HRmo44d0BYavVJUod2n4R2aKlQJ1rNdY1tBg/g1Wt1/oJHrkgsdJ0eA+AYcMYLdCuOmyvA5AlC6wQHb6dIh7C3sjTFYIEx6q243mUr2BWY+Rk0GL21u7u9x4p+Bj53ObcjQtsgOkQ05RwLEHpibsg5JcfBkk7nzntMcwHecCg9FjYeUhwqo/dhO4pch/0lhH519eZGpHtpBH14kvfQJONGJTecTzhuNpTQguhmxQnaTkNXuCIk9iLZUO+MlKOjIo5kcKPW0cUT3oMyRI+Cqx5FTwmaJpVWozpfkdFrxP45jvUb2Pw5ThKXQ68+GkBbOQPQ6Fe0Wq3W8ojam57u2UZ94R/kNiHw7Dz0j5Tbdcl2MKGNortf8ohemOcOJnrClgOaBqnnerOkp7kNLR4ndYXhgM30YBA46YyPJqvhWiycchy++vtfvz3c4Lp81z44Pj08u6QYz4p6hZRYIGjKoaW5UkLAARfNgCZBb6qe16SaE9kGiYTX946E3B2367qKgeaap2ejwUCVhRcobLRs7jqTOWIIc/DhEVpbKU/0U9ZFzg7QvZUlvVoS3AmqW377ZeaEP1NCi4d+BhOtdO6K5/gSmuiLj9ckZ6K0KBqinqRUGJKNTucqac93jOkZZ7Not0AL7yGa3s+ZjsF8aI30vhL64Pj1n/78j9++ffv6cPX5sH14enry7nJ0FHhoCs6KCF2ROX5Z8dZX/uzamtsoLIHQ5ICtnGLLaE/UMmIX2zk5nrmoQYgxBRfGiSC+WH1K0PjOQujE28eiL01WdROxnIRXnN1PkQB6T8pBGUlaGutcbD16dnYqexlJz5yHZj3DQ2tup53CTXS/hdDM6FsFNDy0ZbTxHak4aYhZEJ1OU1b0WDA9njo+W1Xn02gl9GA/Uxr6hDZV4dXD168Pb65eHZ+Y354cH19ejnRA+giERjOEU2R/Pl/1y4QOlY59qTXXOmwIoiOGH7KHYk4Dz27Tlaf2MUVnSrzEdx76pcVXXe7Cy5+DfnpalPMNlrOMcKBRUWg5WsHc/XfrpXv5iGbGv3QQGoaDJI0Rf7RWeKeQtgpJ0d0SBH0vir64JUIbF70gSKMunPADSU/mTGnPdqTXd1D0mCjNkhZZ80q4f8xBbq5jkIk6uCwUQR9gjfDw/NC8Z66Dk9PPl6Mg5ag0eDmlSSNvNTcDKlPN3OwIVqu42WJnO2Rjq1aREWdKDtbj6kZO0B22z/YkgqpOLcced6FWeRspiDNXVPCe/8GsuMNJUafndZkXtSN29v/qFu/fPbIZ1fqOzbQdbm1ZUWv+PLNy3iVFE6F3to3lMIhu0hZWH3PEm2itbH0Iq8JruqkwBKGtpEnORtCT+Z0YD1H0r3+7Vic9zhzZMfUUbfPooao5c8KBNRyy9U1P++X5y5cv2+dtmvg3puPVewj5SCC9wi26UolHlnlZUN1zw96+u+bhuoadw1srNRygKRs2KvHOvbCug6rFuFytitvYiGXajRdS4Iwj1aaCuF744CWpi5Pmu24/j4ha70zap3hmt7G+F/DZOY4nCf3779yT6M8le2eis0EzqVoqwl2B9PYuAP2sj73vLk6aITxvmoc6K/eEaFwXxnIA0cTnBWwH89maDkPpOyY1S/qO3PR4LraDnPTY64IPcyfRhC2WMOpQQkPQB+3zM1MGnuE6PzeIvrrJEJoDZgi6afMNAbTeyuimp2q3aFjS8bhHCU2KhjPe4IMzqjyZH23YLI6kF9WdYhOyIpFDb/gaFVA70s+b7+yuOFcLcrdb8GzlTL0GgM2N9Tx2qNF3LOiW2zpEBg1Iz5DYmXsmhMZgEgi9vY2DDOp9nMvR7Faoq2JEra1CIPqCi0Kj6OsFSZrkTIoW25GiNBQ1q5Ueq5zT8dRCeuiijuy5SsPgWKVBJo7eV0JTWUgxHV83n27O24dXDzejwEOLxWgqoTXbeITQ1nq4GTwldE0rLqrQsmWcCJpXoeLVAnubWJeh5tjKNPtKfOYX+8jH5EtjwXRmqilSPWs5uKuK5oUrTQeWmNDB9gETmtBMrqNlAzvzcMqxgzVZeOg6UZrshlF0Vwh9e89FIQTNNpohzXimZ2LETHpmVkPRKu2xkfaY3oxTEHrsZqOHw8cova/jd9lTwnjr++1B2wr6l3/9fNk+OH24OsoRulHxLEcpWA2sZI+QQXO6wi56jb20EzT36igazicTWBK0qQML2trciA+SsbbDGQknVifjuv/W/01iv1SCQJ/O6jfWdfz5hTuQrveDP5G0rITmDN2Ju8eH7Em4QYTekjkOryR89iyire9unebtqO/Ngv5gFK014cVH84iiOeiY0C2STslI47aUFjdt5DwdS3EoqA4OvxvQu9nT74ykh38ZZDvgcowBNVJIzPtQ9C8jIPrTaKSQzhNa8g2x1nz4V4bQiENKupolQbQIGgqK83OaWqJJPKyzHDqClCDt8Gxz5Jd6eTUn+VIxyZaHQfxH7iaSuE4OkwGZOX7+gYeSbHy71B5aGuA92eK1V4v3VLZ4MIkUvbvNxxj0o26/biTdBKF9D33vBdEE6cXCanpyt2BC82PkPLeaJkmPJfaAnEXRQ/MynBb66KAVXuCjraAPzpjP+2innJ23j18/3IxyhKaUA3McdhRJrDUfzZgXNCsaZ8PoDqAVdJGhWK2poDGaZJw0JqL9IMJYhNhlGyzqAuXKx6J6aDis53DVYezvw9KhM3vVnSq7jT+qmuUcr14wv7GkKYdnO3jVUHYjmc4z2yTsbcNx7PLBHP2Ihvw5t5Ojs7p/+PKBi8J/M6Jvr4nQXBYi5wChBdD2niujxXmM0ynrOYWicRlhD8fDqR91FDA6VHRA6MMR1AxE01kG7YNXr6/ejySMVkIjh44soNl3sHvOe+haxdsJ5/nRkNAFgsYxSTUZzcfpA5xVW0FHROhExjQCHLvXpBjJ+fdd8ueNM9Huy16V7EbVblvJ2XQ6GK9YXnZCq5fuse3wLAeHdnzpQTN9HKdrKkJaWdmkoMMK+v5WTfSFloUSdEw4vuO0gy6O8DznwdaDTHQ61pYh5ExvCxE9LGQ0D5CqoN+eq4VmQRvT8fbN1xsbdGjK4fdNgOyG+ueG1IkFrUQ9brehQ/lFq05u6Zun+2NefaqWY90qtFVh1TawsxFGEr6X+JiOvM94/hrfKAm6g4TnDXUbbr2bj/Ja+e8Ha7SWZcZD+t++4UAK/dOWFIRkORBy9PlqSswh89CbELTqmRorsNCEaCtpIvRCGX0nSs4hmuQ8R1U4v1v4hB7nysJgtCOzkjX99RMfp2ssx8haDhkiPTCmwyLaEdrrEPIsUskndCOj6JIerLQm5z6LoPPTQJ6eeRZaD0+kPxfVak7R/+Hu/EHbyrIwrvfQ08jyE9JY4AQb7CIL0SB7sAUu3NiQcnERSLGq1yBQ5ymctOp2YKo1KY1cGQIiY5C2TJ0u3SIM2skaxgQMArtRsd3e8/ee+6RMprWeHY/G9swU89PHd79z7jlwA5GyCUmaA+2dMcmKcDrXTRsPzeMeS85xHODtbtXnXFgqfqRl77Bi2JAReGCkDt0HKfRZ44xpdjDvrUIMvY9Ar29CTYUVGpBeMQo9RoEeEdEO54HkHOI64HDYYucxezhsUdJxfn7a6vexaNimL0eAdPvIdyodUWfHXNfRnI5+E4UGoF8YkYZz4T/efsnm0KYQKA6aFJpvCBa4418Gi9JrGdRRCBS6OFehGa80ZZvhgZYhzSXo9gwEOs2SnP6hrQ41OpNyuMPgZsmfBjGpa9hJG1sLAvQGqzOr9GEOE7szZzcUaAczW44dGDOzsylzZmjRwgq2243HprRCngNEeiA446HQuY5B/0QeazhaJNHQ2gGmw9kO5pkcdKDQbKUzV7IM081mh9pHQ8shff6v37lzYSfjobVtVAorqND+aqzP7wj3fB6urODUXebZ2WDtFkri+UBzGzT23GWAxrSPLIfq83yXTJYjmkE4/GVMo42JZ4EWoDWps/HtAgAdNki7Ny0rNHQmUdeoOw2uEtBkOdZ3ZJQBXPvGYmHdKjRYjhHy7HAekESjPMNfT9y32EQT0S337ZMQagqkT5ln+GhjztFW49Gcl0Zb0+FvfV++DywHEP3m5c8PX6hH6TiXDxW6JtetgFlepwJoc4e/78TLcw2R7Qac+bAQWKXmfVMpLBPU2hckkumIlsEcdH5DcU6jKGjWSE2sMU+f08CG2J96D12V/rqSn17HtwcbG4vA8FdEmp7DQ/bQZ+Ke0T4Lz5vIM8cclHLU6yuScoxUoQd9auhQE6022jwAdO+inxVqOhc6CyJMI8uzIt3NbmLxRB+rQr/vZCT6/eVTqRced3Lhxp98INE1Hp9EQQadDTmW1n+M7EYlor0PADQufwi38gjQcTVO0D7jjEUFWlui+e5rNOuN5/Yp2Rqh9dpcUlQPTWOiZfyGTq8z05E2Nh5XT/+fABpGdcBk88aeAo0BNLiNtYO1NSqs4I1C56Lr0JtUgP5R7OWo719fj+EZsURDcgcSDT4a42hMOXq9DNDI9GBGoVv82Wrj4xSagWamu1332W12g6YOKoBrm1JgOZrWR4Pp+OnhgXo6ciTGtRmFxlfSWMezSH0kzQfHQp7L3hVuDU384ObZogrYZJqgkSbLPy7DSPKyAbpIfiMbcthYg2uAxnJEIMRWzX0pxsd2VRz7aA0H+w13gFpAgTYK7f4cEtCN1TMx0PIc7HqFlhZSzDnqUFm5BoX2OUffKXQfXUdPVBpNR5Zo1eaT0EpTFs19Su2jUyvRDmf6EiAdnAvnx3aMNZiOX+8f/k0eOhjfZRVamqGxr06Hi/IwGRrX4RW6XJRWZ9z8YCyHEM1bf3DeflICoPVUGBVVoZnoQKN94UTuaJk20Yrt+mDyU98OwiE0LMuSmop01jVgf85G7smCAo0KDc38h4cyzU6J3kOgd9f2jUJj+yhdWalD7XuMEj2inKNPTdF90GjvOthIZ4meifBEnlmgUaIN0u2r06vhcHh1RTKdSTpkV9YM0C+CpOP12/svkEvn+MinWYYHu4ZZHa6hWNGwwx8e4Qf5pcByQN+RjKOLzVURRBaG56N0A8iwiS2hLKQselw23ftzPQbSjp+RduWZHmj6YZRWUo2gadtQFbvsqKZyILsE9X/8wkn0hm8iRQuNLXb0gvQZcD5AolWh6zBRl0fNYMwxIctxNxbH4SSaqt+AtbHRM0AT1L2TjO2gpKPVpjMhIk0fp6fD8+F0Oj13fwGkrYk2Eu0Vmjx0M5t0/HoP5RUBumCnOlNIR3YCgoz8kiwp1LRamkeXuBZOQJfwDkoUQ3+zAJ2Ig04TrHkj0DRZMaVxYFHQmV+JMl37kRpjuHQYVfTuYCevi84AACAASURBVBbo8MZLVf2zHygjDnpLJmY9WTycc5nmpFWupeChEF4cIM67iLUo9CYOTtphywEPWA5M7kZj76JRoHsXn/v+VDh7NMQ82mR4LM8eai/TR+6L02ZH8/Tmxn0ZTodXSvSLsNk/UOhm9lzoJPqn+/8B0TnZ4uYb7LRuUivIUoqVmieaZ5Mu6bBo+F4lks2VkR/YJQ8O5sBMji//pQk0+rNCk4/OMByJa7ZnQ29KKt5lrNtbKqn58JEd93BUdf4zd240cotooHN8t5DL3nLlanW10bAOehd77Vih8dI38ozF7zwBzQo9HvXHrNF4MOxf9NV0DPp9La8EEq0PvW57nFunbTHSoNKA883taHx3czu9naLvmHHR8KUzYzmaWv7GDW+v3v39r/cPDzlFNZBojuVkRLQFulKmYft8YUU2/IAEw9xEGBCaZnmG3iWUa5mjD3tRFOiCJZqPc/NuXWU8thFzMOHc2eSvkPOGQhj3GB3AZ0nniy5QYfArCk1vWG7fYKyla3QX5heQ5WCFphkGPJkDeEYPTTlHVqEd18jzgIAezJFpTaTd52DgXQfZaK/R7gGeHc7Xkw/uv3YzBSfNGv0iXKL8fP6hsKMzZ569+vnd27f3OZ0rGio0Ty3A818INFfIA4UuINBpHFyOLUZm9TEBTYUX7LfDrCPV+XZl0elI7IPpCxHNldfpzLVYJJp+DFuuYh6sVIzRPx+USjgzlxW6sdBAW6HWc6AXapbn3V1shvYKXd+s491vrBWyQl8TzhejMQt0f0RA90zaweWVUKNbSnSvZ/2GEH1K2V0b/cboerK9PZlMHNAg0d3mvGNhJ2M5muZgiDw/e/b05avXr3O6qSeIMTSLXtcL3jrEQIft841CKMCUpTM01WZRs5oet2Cpq9bptgk3+ctcD1zJErEQQ3Em4g8JQdy7AIcklSJeuCLvn9S+A+IilQgh2+B6CmytZ6CV5ycbC4uzLaxAc90eXYsVhd5DlveBZ6x91zfRbeyIQrOHnqfQENwJ0OZoODeOztTBRaFVo4/IcNw6nieT1bXJ5PpuBBrdJYl+IdexsgoNh8KPmSPhGwD68tJxnZNNPbg2xaYd0kRaq5n9mlah5c53XhQ66OHwW6+wmogTwILZL2ClsVHJT08QhQYZxplI9Af/Nko141DJpt5qUXH6Ds8EqdKClmpECo0jGb3jaCy2MucotmMLjUSz8xCFdjyvAdJc+V630+2sh0ae+/CBSfQN5XYDtRyW6HlUh+XCtkYdYjgc0P0xCvTqZHtyjUCDRHcDhW4GKcdxJ4w5gOengPMvcHc251epzeTR0DuKxVAkOh8A7Wf65xVoDjUEaLhSi1uCCgUF2it0korlEKLLALQa4ETtBb0g3S7hv6CEv1KS9w8TXULAEei/SAs0qXTpoERTkrzh+H7jG02ij1m/ZxT6cI+CO+zqBxcN8rwGAo2XvndEolmh8zCuUSV6NL4IJZqzaAbbBx5ZgYY+jxNfYlETDTdYxHJ8mt7e3n1wQG97nlGi29Nh07ro53oF6zgM7Vif3/wiKylEoQtcDszchhXLgUNGZxUaF2AR0MWgDzoiouHmQA1mk5Z1fWswUh90VImWmiENrGNTXGKw4U9Mi92A2pi2DyZgxkmleQcWtUXF6J9j7uCQa7HmSLjx7daeRx5Fb+kcO+zp185REmhkeh+boevwyTivm9hurBrtkEYXDR56wD1KGdMxR6TdifCi12p5pVYXHUj0zej6gzMdH67vnIf+DYF2KLcH064luqNAYw79sclYE89vcDFFh3LopZos3Ax9dKGwLiuQ/ea2vHroUKGBxHJUNldE6MrtEldt1iv6kzTODOkC9qWlGrdYxDH9DoluZH+1ijM8EgpLIt23mSSyeDPGTd5J4mF2bmO5ugxd0D+YiOPbQD9qj/2Ez4SCtN71Js/haF7bUaIValJoWPdtFPpi/PkzEY1Mi+vgc+Eftiph391JWDMMTMfw3B0K/zYajf+JBnqKQTQ23XUhvjMu+jk1+P+LPHTTJtDuB29k8Uonx/tbeYtKwTZ2SMVQFl6ZVVe1vG4opPZRrfMVLdE4kMaWXlShfet0kqLpqPH+5AgTa5iNG4tjFo9Mm2RBo+GYB8gn0BiCpXSmmnPuFMczwa8tw8cyXvUGnn+QjW3fALqxABrd8CHHqlFouqmCCr2zLyyv03g7TDnq7KH1TDgGni/69AwM0FnP0ZupgUsObUxHENydUlllens3mt5Op5+GQ2xUanalP0lF+nmg0B+bzDTx/OxS1iUf53idFYi0iLBRaJBoVui8TsETy0EiLZe+Q6BjkujaUn4WaIWZzXRKC8ELBmj8UcSjn+OIX8oABCrQeKCTkgG6Gju+EWjk2D3//c49/9n6EQS6kfsTlqPxtXm0j0qhtxq08YpRPls9M0Bj0LFLN1bqOPKcQ4463lhBhR4z0o5mx7OXaEG6PxiEluMrccfJHIVuqUQPP2EWPUWcr1CgoUWpG0yeUcshCv2lI6sKkWea1XHMCr0iIl3jjd35YJSdHADzhZoGd7W8LlipYWwHXiEj0LyRnt2xB5phlPWuJZZoGo+H92h1ORs2msbu+Bfr79N3+B2BQHu/gS5aTEhcWq6WgOfl3wHo737fAoHeamQFOHP+s1NpH7E+b7Dl2Mso9JrkHPuU2eGzSRZac2hnObY/SBCNxW9gui9PVqAHg+BkGB4OWydhn1I7NNGnV+dDeJzZGOKB8Aib7pDlbtfznFFo1ufXl09fvnrpeYbmJFFo6REtBPdhJf+QuqDOVNIL37BOeb28mRki55xBhRJoudDigU6qfgRuFTeyxUU8QOLe5GKcENBJ6jfSs5J7bhn6/5N3PqFtZVcYlxU/yXmSasWCOLGHFCRBPER9KAYvtPEU09kULwzeZDZd1LXAO3vhGNKNFgG7uDAEAkPB6EESg0GNTSS6mMa7CS6ZoOkfQuLgjpxJ2mzaoGShTs1A6D3/7r3vSZlZdBXnPdme8Xj545vvfufcc8BsOPiHnptw4ePyDk74xwQ9D5Dn+L0CzPsqhLcYhycWnItceOcdhywSsiy02A34ujhSKpWKimZzKCSV5inRAHRp1wTRPryPar5WaB+hrtb1CdFqKQ0XwnXf3WJQoDXVO0qm1eGw3FjeWd4pV9BxINKV8rLpVAoeCscXrpDfWMnNLs3mjD7PLUT0JkJRaGOU9V1Y/C3uCMpwG7SZPjpEle9UeNI5AJ2y9loZoF1aMOjqIaSOM5CieUzq+8AAZ3rYwkR+IkpeO0m08q8dsRyWQnua+IR+hu8T0PEvDguFCRrBYl+LhU0kXQHBO6/QkkOblAM0esSy0IrnIlqOMZrgPwbTk0ih+1Ghd03QIUk0Py0/oNJ16eqo1kWjq2ErjddYeuUcy4D0znR5emcZW6LxZaKpwFKZeotCK57PwAz/zdtzpqsjIoYDf/bHrMUq4jky/Rm9VtZcVJHNbmg5RkND5LRCS8FklPotBrjJlNa/Es2AJ2+xT3FB0eG6C+LLbUxBheatsfAHSTfJKHsa6wS0pyYSnuLZY4FWjwD9Awotg1re4ZTDzqFtpOEuIfJM4synQrwjK6NmSKHHRnb3rxkTrV4ly1wAR4U2zqNKSNtNpYtd7XcMdA+FnqZAmnr9K2XwHHS3sBIYSWpK37O3zV3CM2dyl5eWlm7LsmRWaKSZJJpuWVk9HXKPMMazG2UvlikUZmRpbAjoPq3Qg7FBVOi+Pr0Nwp2kejTaAwdvgpuVQo48nGHgEc+c/Uh/7azOcUNIkzZ78J69p4F+cEgjcy/Y12Fpx2p3bPdO35i1FFoHHRMmhgb/XEKcL2rHIUk0jjFgD615rtELQbQP6oxZR01nd3VpV1LaXQ8SLb3+6lvDvrxS1lnHNH5V4AN3sfDiCrloRjqo0Aj0+ML43sI61AdzM5dXV/dui+cAoMUqS2wXTDoy/ZaZzpBki93gpZsx2G3cY5BdHw/jUEYiTx1E1EZEOE9STOxQPMGj8HhHluZUsHYDniJhVDqhCRbfPJlAN62JPoyb50ahUIgUQiFz96Hw2PT4Q8ph5XWn8WZsaQT8BpoNHUOPFbPFrOySHYNTIXrog4NrTDTiDD661fJJpTXUPkGMINdarfpisLmDx3VYJ8MyGw/9TMtP9ew0puVcCKZjCt6eCj23vgk8K6LXtrb25thDLyzQFawh8dG6ETpjZoJpoNmCaJilwT8VHpUv/w445+WCLNdd+vK87tJNw2ABx6ycj0KnaT41EDXVEhZpyi+IZ4+gToJRAXA9o9nIMPxno8/e4X0L6OdnIyjRhe+1E8fgxuw5HDOigJ6YIGXmuQXCM3poCTnGLiptzmZ5PbJRaLYcLfDQPn0U1D4BXSfPUav787utOp0J6xx4UH63WK9VpdeffwayDst0KHWGkyFYjma1jO6ZeLa67myFhhPhOvcj5XKz220cnER1lgjvhyWF5qFfejx010zofqm0ZGTeOViKH3cDDd12NOaLw2WLeuQZ5hlE0wIuA02mA5I8aC51yCBDbRsr3N1Hv4BUJ9g/I8ronxXQt+L28429xu+YNydFcAalldvReVABjQGHkWd2HFqhh9hD719TQM+TQFPQ4VsHQ7HRNfAgRDLFd3W6l1Wt+vM1A3Og7a5ZbW6E4ju6XliZpsoKTjTQjkO9YaCpYXRlc319PQfXCeGCLD0CtFboTCzoosNA4wosae/gduheCt2XYqGWfW80H5qIzuOQZuI5AY4D6FVHw7wAjW/ShZEIxLRLQIdZ1qaZ/IarlZlE2vNuBIB+rud+HX+gSaGt2YwIdKlUQpyLJM8/Eccxli3y/nquFIJC65QDXLTSZl0uRIGuGR8dyDxEpJ/6de2kAwXDS4v1zoZdL0SFxrkzdP97XFsOVmgl2Broyw8X9l7cXkF5nps7D6XCpfbrV+w4cC5HUKFjb30yRqFlXxDNLe0b7aXQHOUFaKf9WNEoSDQGHZxX4NnQGVBuW0EPCu0mHWI56UbD/HoBoj2j0OQ6XI80GrC+eS8AdPwuEX3u+AN9gZqTJiaYZDQcJUugMdwgnEeLWeaZBHoMbqzs7yqB3p+HurdWaN9v2SVwArte6y6F17lRqWpnd4t6vkFDTEcQahlpUOHiimn1VxJNQC+tre4t7D3cJJ4XYFfF5srMmiE6EqPC4JD20KFR/SGFpoTDGqM7+j0KPZDPQydnyhrSDIOh0w4WVIBoqoskGegkjlnC3owoKrQT5DmszupFgIllVmh2G6TTd4M8x+//lqK696AfOkJL6kGhR/RNQhToi5JAS1PSqLLQWcaZNtjDrW9lOfBM2JLcDj7+Vd84DgU4Qg3nwrrVT1q3og71aQaQblxqAst2HF0hniXpmOaxM6LSeDC8gkBfXt1qv9h7+JcZ1mfcj7WZm1ltt1+gjZ6LULWbFTpmyt5y2yQVVmiZocvlcFToVFfKwQptF8SjNGiGFBqHLLlpsMlWjoHVQwfPfEk+92HMPIn8uyad84xYi0InEgx3whMX7VkhND9/P3wPrl7p9lFF9MRHJNCkz6URIZni5zEqfBflTAgHQmzmUAp9DYO7/RYeC4Fp+NChUAosCDIFHVI5pCuHdXt0R9W0dFidpJcCBppGGiieyzxzhnHWx0Luttu++8v2q+21WeWfgWfa+KY0ehWHGIiHZtNh3xtMUe0uZZX79KGQq+XiOBTQ4YWArNBKn/vyesgM1f7caF4r9CSWVagyIj6YgEbGoz1EWUcdCUI7Ib9CTbYVGizHqS/CQMefn3pPgMbCygQvJDxdOq1gBoXmhiTzDTZgFU3GoZd9q1Ph/H5rHnpHW4gypByP1Jct0ew2TJWlKndo6WliL12TdmUpaW5ooMtmMhiJNIhz2TIckkRTdEexXW527c6Do/aWAnqT5Bmf9c2V2W0mOmJS5iEoFppGDnPBNXwolCLhSdbzwVFz3gukHIpm80uqczus0BzEOQFYPZ0rUwBNd2pdbnue1ERzFdCk0onQ49HJ0Pumi+f4vRtQHDx16v1Q6EBLEig0PEU73qCKigCNGTR8AGjI7VotlGj/4KoItB9gus4e2qobSjFcSXPn663Pnz3714uvO53HjxudZgdnRjewxlK+VK02jOeg9I5UmkR6nGzHVAVLK6TQM7NrW1vbl2c0z1NMdG52qw3T7RDoUUifzZ5jORemBgd7AE0KLQ3S/aTlg7FUYI8xbtlEZe7DuUVQIITEApdR8EgwBzvtnGBc4dkJdBr+Ok30p4l1Msue64kM449uoBnqboFWNvoDhfPwqeFjDvSFCK301kCfHmGeEeiseuE7aTMrNNVU4Mn+GyyH8tCAs3oOrraukkj7+NHlb86kBeqqxBzqq1N9/LfffPLJx/99+U+FND+PNzYWqQauvHSzYR8J8eU5d8yzORmSQp85A5uRZ8BvKJYBc3LROCOawjs9224IRvGf7Lem6/YEWvZQDNE1w36sbIM3obVtGDoDzeShleNAG419+fbg6KgTAtp2xcRz0jU7wV0cTOPYYQYeBxMo15ZCcwDtsUj3Ajr+QAF9/BUaRttNsEKPcBMHWOhS0ZZo4Br6kooBhcaB5wroXUX0/DzyrF5RaLHRviY6lN91nnY6f1ZO43H79a0TJ7588uuf/fX69Tt3rv/p8+2tP/7u9SOfJhs0yub+t8QcGHVUaLSuIE03sQTonOQbiufGDhN9fm59hYnWQCOk4CIy0sDxdoWma+BDbDnAbA8C0ANYCcQfSDVOeoHRn2lcGusym5h2OLBRVvdhdAfL1DbKs3l1MIdyDBKNOi3pnGshLETDv3i37vUC+v7Zd2KV1f/D87BW6Amj0JpobEkqEc1ZHAGWFRMtCk0eGng+OJhHjVYHPzYc2nP4Jr/T38BwdF631XN0dHTj8EPX/fTEl4rqmzffqOfJk2+/+ur1PzoNtB0bjXKDka7gl7EbFHNYyR0oNIzeIKAhr5s6r8yIsdGK6KV2+5UAHYOuQbYcMqq/N9B4rYqRxo3K6u+w7ROLgSmqCBLUeCzkm7FaoWmUBp730tpdhB7dmoR/34cb71GhE9y2QWQnpOTtGoH2PObam/Q+vBHv+dz9ERJ9vGZChx+sq3zEOJfIQmsPLUYaQCZ9LgYVOouxHSR3oNAHrasHQDRF0b7lOXzLSrNMP316dPTzn/7qF5+dGy54MIrWg9OR9+l/4PnuzZuP//Di6wZY6Wat3rBPhWI8KLmzozvUaPTQSPTmOujz1NR5+4He6DVFdERgPYlDRk/KJPMfVughmdQYM5YjRQNmEGfo5MjnzWEx7Rh9BqATfNDDAWFwdQrEGhMPJzA0iS5361ohNoaiEoujttqfPYEaNbpwvzfQ8ZvD1nPciD4ntyILpM90Gjxdgo846FJRAz06VrQNh/HQu9f25xXOKM+k0C3fPLXA2dBqVqo+2v/sg9976UFgQqncqILZUf+zdpwEMv3dty9fPtu7srzRaPr1RqD8zTaanfS4HdxhDp2bQYH+H3nnEtp2doVxSyMp8l8PSxZEk1FxwRHYxiPhBrwwlAS8yKZmMHgxaWAWxtjgXb2IC+5GUEECWQjTQjdhBHksipOaRllNsktpiT2eljIqCROcDHZqofjRRIkUxorSex739ZdNC+km7rWdTGaczCx+8/Hd75x7DvHMK8AV1fD6e/zi3ouOgJZono90qEKbOTQQTd8Fn9alkEI7DzVmpNNB1mRDoMF/AMunqUyoe/29mOD5uSPajy9lvR7k2av8tqoIhvQrFcNEq6p3duAwoFf/XxRaGmgBs7wRjiDM0kf39vaT6cAv9s8K6EVQ6O+m1zXTMopGH601uihLhk+fPn04JHxj2NMFi2kjqUi68FE9S+NmAef9/ebLnV/9eu7yLDwmFMeOo3UMzYdzDqXQ+MC7Ty5N7uvLSdshPAkSTSlHhDs0rPl1CLSpz+qRIRPN2AvLEUh51MhRxbWQaDgENIyOjikPLbwxtxL9hEY3oleOUb3FcbifXw7wwDnqqNAhboU2bUbIaIDO6sQO+jj+dAjQD36UjEqgk0dOoNX/oqrsPYJEy9huCHlWnaM/pjeysq6iU46HpNDT698phV43roXqZni7eJtV+ovNFy+udXgcmJqJUbBAOlRvva3T2d9/+/bdu2rtZaNSm5uYvSDuhCrnyOGCCjPq4IlgKNOjOYjtoFQ4MS4EmvQZBTon74ULCxB1TPxBWQ6qq3T61BCwiFuh6YVWQF0KO7FOnoLpSKDQEb0UME2+A9uh07LkTdPOY5RD+2Ww7FA6zQEIqbRWaDkET5yYVGhmOGS12VkKLYF2p9Abjy2JFiJ9FJW6R40epbcqWqE/YX3OwKfbRGsLjd2j29JCr0uBzsOnMhzaSwPLk+Q8Nve++mblZsHxpBL9gijx5yQi2f1m5a0QZgHz23e74lQbb2qV3eaxG7MXrtzOL5VcLXf4aCVHnf7WMAO4FI5PwOSkvlG9077PcNJIdAe1GyUCKe5Q4rI2Eq26OeSUO5oLxmNHaSUFDvxCdvk5Ck3QTatZjXAtxMcp+PQKt2Bh6TuEoowDNoLklamtg7XZr8aFYSOp63G31Tka0hdFSjv4uBo57kevS6IfPEm2nZ6j45/ljBndYnc8Q18jmcxQBnDOwF/0QgtHb6/qhe5WRwC9CJVCYaENFy2AzhdtqIVGo0J/dnvp7Nm9rx+t7K/UszF4l5hAoiOFVrNSfSdQRpoB6OabN83d3cb85X9e+bJYXGvz0DPENCj02JWSTDoWqPQ9N/83rHgzz+bFcApLhh30BNYnFZq7QnHahtnJ4dPlcWNmEmDP4wfSoNBeBDqtB+kS6B6sdMewyS4UC+LqesfhfrsYDtqQ8xsVyO6jgc6aT1ZUrdAorpwW9ll8nnhslwivxeMq9ngSxzBanCN5OeT3hMOD3Ji0PTKyrWiGj8wI/NQrBBqZ1o5DAn3v4aK4FILnYIkugkDn8/liMV88yHgInX7x9c9X6q1WPZQGlH04mDd8s9UsVxllPJXGm52G+MWDiSmaYCAffy9Tl9IYxHcccryeXnzNd0NUaAH03Dxm0G6cF6RGd/jkem+Y9OLTjfs+Fl6rqhJQRCc6pULjVA5eVQikerDBLq0/0rho2ws9HKjQ4CtiFNtBexIqdNCYdycVWn7QZ1tW7ZchdsgIqaXdGMgODDxx1bxPxOPXH6iOjiR8JONHLu1Qu1WOn1Fd0MpvAMUj1ASdGUKie/u1QJsKDYXvRWjmeLh+XloONNGAc94iurhEP37x29+sQC5X8Kbwz8AJH7F6q1GuCKKrlUq5Ik65/OrNs1pVOI9nN05dmRGXwhIPM5ghpLXnyJ0q5fOvT41aCj03f2tK+Q35k2IbgOZHKrSsXs5lRIXuoiKg3IYMw420jtPMJK3QCmgMnyP89ipNQQcOakxj72gI4jtAGRU6BqZZ3f6csJrJYUm02spiNdiZf0fdErNE9IA4Lgu9Go3Ho/cNuU4y1EdRoXnpFS+hGDkj840MeQ3hNobox146nHEYCn3vHkr0tDbRRRBoQXTe4HlSp3fFv/7lm0Jhv9EaiNC8R7AcCafebJQF0UKZy1tbW/D1akcAXa02j43PXqF6oRrQsYzVFbwWkoXWXaSk0BNzl+ZuTVEKPapxNvqUOvjJFT8j1I9fD2z0T+HFMGErdIAVWmALo2PAQmNmF0hFUvDFL1+NLJqJhn5onIUUDLvwdUk0/sIfdnlov2071KvC7GkC2q57PxAuI6qAPnYVPEcy+rvo0fPQg8bkfh7NmEF5JsshZBowph8NeTYdR/c2jOVYnIZ2OwY6T0FH3lZoqhxOio/i53//aiVbaDVa2WCAkgNopfDXGwS00OatV3AE0HdeAuC1O5fHzoHlIKZx2gx76DFVKeRL4WiOU465S89vTc2OStPRRvRUBzlochTG61d7rG6Eu/3VOFKe3d/moWENFjSMptPKrQDYaWpSSsd4KScO54951FAvMh0a4HaFprnQstNU4uzXCm0mHSTRNtD3hV82gH4cBfscTR4poDmrMYc0cucoAQ3yjE4alBn1GYPo7jbP0b+tFHp9+jybjjxpdL5IH3wm8XK49NnS5r9W6wx0RBJtAl1GoH8QQL8UQO8C0DdOlc7p5G5ZML08oyOOMRVEa4U+OX9JSPSsyqFH25DuIAedoKZon379akuz0UrKMzk6qaro8tB+b8yDfdDw7ZBvB0CkI1wFj8X8Xq/RcQQXwqAeRaq1WdkOTTj+5DjhQ56vuMrfgmdXI8eGwNcAGgQ7fqRSDhVwfCrnnLt5zpBQZ6TZIIk+COhe6h5dnBZIrxPQebwTgolmmifxk3s7Jpc+39y7Wi/cbP3Qyobls6ZuX8D5CIGukOPYYqCPCaCrjTs3FsS18BdrponGBiWw0aquQoXv3AIBPXHp+bcAtPQcbRLdoV54J9hyyNyOx5UrjQY/nUI3LV8U4m9UHhpUmHvphEKDewaWEwngGqYTCCsdC3LSHAvje0KaiasUmt6pYHjHf2l4aLdChx1Jd1a+wWLLEToNd8JhVyPHddDjE1q17yZtx/HBA52UFUJDofEtIRB9hhUazQekHIAyS3S3YTsMoBdBoaGXgxU6T7YDo7u85TqKZ4HpF3utev3mIwaaF6R1ZQHoigSaLIcAugl59LNfzgqfsbZWKp1TTCPLM0atUJYKSaE/RhOtgG730H0dqqwd8AXUQKRE+yR/s77is/r7U8hzkGI78sqetFR0encITlq1+cO6CZ5nHrYVuu042m7gKLDwf6HQIXLQA9dcFhr8hVE7vAsQRy2J7vlAoTbLQoODdgRN72JJm0mkM3AtxJKKPN0HAH0PcrtFvBSeV5UVuBSi59DpHbhnjDn+sXd3XwLtox4KwbM3u9KoYbphK3SzuruLQJfOUXBXKuGlkHpIl5VCk5Xm0jf0j47LW6HyHKOHAU1hPt2ASAAAIABJREFUtDYTPj0h2gW0j4HGfd+0s42m6fLkOoih054ALVehWWHgO+gbYAgurJjw0loge1a0O3s2r4dhthyg3AfxbOZ2A8MDwzbQ3yO/Vy3Ck8y0Ed99sDz3wBbRnh4OoAeH9YVwRDloFOgMHiqqKKbdsV0GFfohxxwo0XllOpBo9Bx5NB2TRcg6NhHougC6EPZ1UyiW8jiFlVptqwxpHRMNQD/bEUBXmzsfT10QOGOT0gwH0jkIpFXMwYcVehw2twkT/fwWxxwLByl0QgOtUrtOY+i5PV6XgMZGDpRxnzLY6umg30tlFeFR5PKhQKoLZDzokfP7vUyx1zXN/wCFdtwKrVTactSyXynLAj3s6h39Xhjo6IkNd4cSnDi9YPlwFZr8c8+gpc+kzqTQGfYaKNBoojPUDG1qtJXakeWYXsTU7ryt0KTNqNEywRMqvfnHn+0LohtoORIB2L2Q6vKECOgy8GwDDbndySlYWr/00+LaDFZYlnEQac7U55xsTmIPffKOAPrb2T7tOUYPATohxz4nDDfBa79dSKMx8TH2KVwTy0BjFdAr7LIwzajdtO4Q2j1SkIMQ0F45gV+XU9S6t+ABHoQV2lEu+5DJSSrjQKKvuYFOXttoayIFF82J9AcLdA/9h8slFOphLPbzY2cS+2dWaDQgGbDQ6tCbQtk72kmVb5BoUGjTQ5PfwKxjskhWenJpEqKOzd8/AqBRobsi8Aw07PE4WRNoJFqcGgJdQaCh4+7LtRKFdsuk0ET0mEU1Ww64FV4koA/OocWlUA+C5gEFaCZ4DDT9k4TNc0IurufvsBVaTvRioA2FjsgdbEHaa0UB9MEKTdsq/GErv1PzDQ6x0A7VVeBK6FbojROrG67mu8cb15VCA9HvD3TPe5z3+LcOit89KM0GFFOGWaBHDL9hKjSZaGk5hshE23UVUuhp7LbTCq1ju6LOOugw0I1aqwBb97jkdbO+z0CXt5jn8lZtRwBdAaAvl9bQRFMJvITJndBosNKGg8Z5jQv8YmV87uKlP88aObTF9GVOOQKyvCIDDD0k2lq6gm6Y36wg9r5OYzeKh4AloGGPkCxA0u9MI9F+tdfY6w3/R4XWdUNHexDH5T0shWaB/rQt5dhobyN9vLr65H+p0Mn3OO9hN4hkvZbw+HH9yPsThTQQva2MtFElbFPo7v7j94hoRHr6/L9pO5fQttIrjlsePWxZj7kWVJFlVHAKjrFl2gpDAoKAaemmC0MWBUMppnSRXUNhCulGMFoEYghxF14OJPbSyFTZlMwsirMJRlBKDF0kMUNobYwTiOSJS2yP+53X97j3SnHszJX8GCeZLOY3f/7f/5zvnHnK7QzT5DsWtIVWpuMtAn3EQHP+dO8dAQ1eAz9Qqt+/bgPQOwQ0uOgW8txsoueo1Rq1hp3dSQ5NN1YgiX52s4dC08TnuPHQ7DjolXPXYlF0wQMb4zzRIx73AR2h2eUyV1r/0Z9g653eB9tboXnXCiPM/Rw4vjGU5yGRaGjjmJqZmvIB/e234a3Rz189fEiR9CdQ6Px59flCf7NS6Ekn3aAOO2YZUw5HoSmKts6EfoW+sm/xjDRX1cuVaP5iFPqv3wPQHQKaRqrAFZWjTvsAiD6AzM4BWis0aDQEd0qhm2yjAWo7tqNKId7AunHnztra70WhQ3JosstRjCxykjCLMBeLvvEz8WyRL7jIruScXoE1IJYjgn4jm8aQgxW6yArNi7nBc8Q+pNBJHW7EqEma5/XzYZGJTtkXDaWRY8YP9JPNRNdnY8UDx/EDAW1iwW40XyQFz4NCa8thDeEgmK2bKizTbKPBc5R1yDHmphxQKPyaDoXzIND/qALROrera55ZoMFDA9BHR+876lCoHIf6D6EE+t49APr9DttnDbR46LsvlDa3SJ3RR1NDR4PvYfk8NCo0J9HjH1BoXkUxaDx0VLxI3F4vq60x/S71LubiaVuheYtsGpr+s75iY8Qsik2ljEL3fuwqC9VcUqFN0XzbGwV6aiKg0InNx0+7Ev10s3AOhQ4TWgtR90dBwPOfyksroEWeuaPf9s9uZocOmoIOcyq84lPoMa3Q/0aFhlf1ZQXPhUQyh9ELUi9EhT45OQKFBk1RJN9ThkO9Pjs0QIOBVkgT0Htb7bm7DZh0hzex4EwIYDfAdOAnpdINTjlma+KhlYn+4s6tW2SiwxXaekShhxWp9HKeHK9ei+vpSXxpC+DlCdAEdXpAToZFLH5DDI1hSJbJx0kzA2aNPe8r5OXeMbvyTfqcFP+RSqUCMQfldXJXBRz0xIznV+QHmY3uGp14tXgOoAN3BBxm6Vuv5C2V5FsbaLgEhtmx5+r4x3cj2YaDhtiJfS5b0bP/GSOiyxRDj1k3ZLGucl88xzwiXSWi6y9Fo7VQs49++7tjBvreO/v57LCj7DMTTV8A6Dd7e8cK6BfioPlYCO1J4KAbINKNZqsh3RxGoS8D0DhnJkygx/vsM2HUtI+aDn7zzslI0qJZXqgvfg/Yq5Ej2ken09zZJMmHrPPuj7G1cLqS8J530r61AqocI8uhL7LEQssq09pxzCiiC/5MYzvzvAfQiQefQqH9JkMd9RSu3hK+4Du+9sVI48Ab4HnJu4BCTzoGesTyz2UTa5SDXNsKTTk0AQ2pHQGtj4TzaKIrFYZZdBpqKwss029/eXx6enLSaX8vNwjfQeoBx0QN9BY2KBHQb/Z2CWgl0S3jOhrkOfBgWKNN9gS0KPQlMNHoOcJr3306yOCpdciplui4X6Gz7DlEoYnxbFomJ5lhdkhw1rq6EsFjYhHn/eNq2JSj0CS/kZR7a0W3QsdiphYeptBDNtFTEwE5fp7JPO4F9POPzxq6nwE1s14fSDQ+So2tbqhlTMDhVVJMe+e2HCWM7RTMU1ROuT5y/UdSUHEUGj7vhxPN7UlaoLkb2pwKUaGJaYVytW5LtFbovyhIHaDhFuHREaUcGEJjtfBgR4DeA6AptmvpkIPfKNA6uLMqhQC0MdEhnoMVmgNj6QwlXKPDOgKhQMMaGm1tkuX9gs7WiQF9CysrGh1JF3kBcpE1WhqhjUDzrvqkVRzU8HKLUtIdTKPvyCal7o2NHAGenzzMZAorm91F+un2ORQ6H0JyKdyFoLXI6F/MUM0dUM7Tv+d88Z21wG3Sat8wh0C6SAgwXwvQ7JhopxuaHMcfqMEfiK6C40CJrlp2o15fINOx8BUCfYpAH4E0I8/wdNoHW3hTZUfK3x20HG92O4/WXnwH9plMB5VWgOom+uhfN2pW8Vty6EvcziHB3bobQ4/30bCknNlTTzFHiIUGhS7Cg4VF7aF5VSzNAEtH/Kuw9MK2LCo7NVrDlrcIF8BjVp1b76o3nf36Uooot93gL4MMzIXvCSS6EOB5JaMQ8vKj3X305sXrKMF0OUMvpcYZ/BT2O/h9DqBLnmg0R9AjUlBhpKUpCcneZ6L3Sa7BQ5ftXg720IMEtFFobOZA06HelbrzLNDBEBT6FwZovOV9dHKqPMiR+skh372icqE6FP4HPTQA/S/FshJp7aLxTAgK3dC5HU0hndU5NLZzcE/0eJiHHhxEoHl9Cnf445gC5TxsC40KHVEv365vlOi0zIT2Ax2RrfZpKZrnmOhUTLbX64JgRDy0UWjE+cGrjMQcMeeqypCdQk9Tp91MkOenK0CUwuYTA53/INC+x2nxU4ibn2fO18W61Gcndm4/knRwuF5aFHrspy7QJuW4sm+OhCDPRqGr9YpW6AryzBKtFFoDjdKMIzjUP5/YQBPPWx0D9IsWEt1qSsEQnQeYD5NE1+z2UQU0tnOs3eTcrjYbBHo4V8wV41GrUpjL6WEGCDN5aVDoH6dFoXM+hWaENdBY3pZXSgGdpbhvWCox/f3WvAJU6Egygh9Jy0MDr5uJxMa0VmicQhob0nPBeBqpcdDBOGMDmIGPBz1M9PanV2j6WwVa9S5kCosZl/a8Qf2jgQaFXpKuJFDo6yO6ZfTaz4TrEdTlQNxh8Wx3Jw1iyPH1fatQyAINSL8Eoiua6Dq23C2AQrcPCehjlOZTnFqAx8TX76ndDojGjg4X6CYiTfIM7wZ+wcSuoavfNfHQUFoBz/GMTfTtxp9mgx4agJYxjPiW7UC86ntQKzR0GRVpKhiTrxWaho5aCu0QDWNJiyLROQR6oN920DHLQ1Mbh75HCLsGn0zEeNcbL6f38Sx9o9MhfgOARpwz+R7VlcT2R6Oc76nQhOpyJvAssw2BbwtCtfEcH3E49PqWvL7JUlChR2yFZnneL9vnwjGO7fS172HJOQYV0PfvS7fd/LwE0Wg44FWvGMcB+owSLUC/PjyRIRxKoU/hJ50darYToebmpK32nAJa8dzSwR3aDaAZgw6roWNW93LA68YtCu6A6PVarxwaVHhQr9yEGE+q4rTV3hwKc0y4VmgcluSspsCp0AN6ohKGHLL3gpfZ97tbJ0wVxamdwEnu1bTfavgmnNOEGQV0CM+JDaFl+6JA4xHubE/GdhxKmvHTciFfYFlmlgvL8F3eEumz3zcoYSw4uYQ4I9J2ZZDTjWuBeIOJtno5HA9ddhQaS4XzYDnYdiDW6rmKPENuB1Qj0G/2OjBzw3pO4SfabBDSADR8bT96htpMPLdYotXTFIm2ToXW9FG4/A05x7h7InRz6LipDJrOJHTVUVFn3aQUlWWGPB8M0zxZRzjgf0S2aZu9KHo2G9FjdW2ercY6efCayYqMk0nqJcjTPqJpIEc+zCVvyMyClQsCjaIcii/fEqAvAKqnDqEa50IBkVY05zOLeEIsqDd+yiPrRLRnWezSmeyHx81J12d8Drps4rprXeoqNs5jbKBh9H3Z5zjAcCiiq1VW6Ar6javwiZEGD90+hHkyfqDf4Cg7zTN+ENBbBwpotNDq+dt37DvQdagfUsGwRr5jtlZbH+fYjtYhP7KDuy4KzVTHTWeST7z1EsMol8pzdCEl2EKK8pzy7UmO4OxH/h8AUg781aQsqscdbibuMDxPgUA/L8iy+piz4dvM0GWkw8uBG9RR53krF1bo0LMfduzJMCa+L+AB0gXHayDToNSLALh+tOuAP6zPh2dNEUuTpSXrUuyIfSIsO4fB/S5VFayrjA0z0HLlm5FmgZ5neRaggeeraDrgYEiWYw8VusMgM9C7ADR2j+oHr2AB0HO3yW+0flv/jbgOSO1Ip1mi+cs690Nfury6uqok+tlNuvPtp7rPkmeLaB/K0bi1qiIe52IMAM5Ap+MRXz8HIO1bXoizH/XsaVZo5pmT5iSVTuxBSdiov50UoM14GWe1Co3s78IzKjRSdmGg86FAW0NrPFJoyAitEyGpMJCsQF4cLYyqD0U1fCyiF8EjY94OQc4KtNcnbdCyy9u0b2h9DpRU2EVza9IYl76HYSkJnQl1Do1Ek0JXMIquVDC8A6IXSKLBR7/9koDGqUhQB6TBX3vH7deHdFsFBVq9jwno3R0G+o9MdbP5jaDcYKAbJudYX5MJ/qtr/1TnwmdrYqJDFTpLNjmnu5Gk0BKVZv+46YuO0tVXmh/GCp1N+4GW3YT6W8nu4hbQA9IvR1s4zcBzkzYrRJ88nnDakGQzxZB/QKPXJZXb8PiW1YUtR2+FhuFM9AK/4eUZZvwwijw6ulgYhc+jhYKj03n7fMjllg/7aPLQM3roF45I0gWV0HDDVWhcHStllSiFHH7PoRWa3+Q5RJ4X6pW3X3Z29xDoXQUrzuHAVGOnTR6aGu3gfZh4hO2jW+0b6y0NM7toPBlyZaVmIb3+czwUzs3NEdBf4LWV8R6WI16M54p4q1XmyUhzR9QMV5Juf5yfFOc14VE9t4OvYX1upvbzt5/jUmSssbhA94vywtA7QbZfgIb1KclXicTToQDPQ9NWRz/FdV31WXvozA+o0GKZPXiD4YA388zqrPhFbXafwqjlPLgSIzXGs1VaJkt6Vr/dL1rmsTKhBto6Ew47KXTUnAk1zsgzCDRG0VXJ7K5S0AFEf1X/1d8Z6DZyS0VuhfHB/wBo/ahfOHz9iBv8V/8rQKuPb2yFbmqRlqDjNs+HvnH57trany//n7Sz+WlyTcN4aWxHSq28LbWipQtYDAmF6HQSMSEx8Q8wJi5O4o4QTFiCCSSsWLArQ6KbMzszCpmNpE1gM5GuJJNwSDMTA7NSG0Y7ErSTMy0GQlyc5/56Pt62qOPbD87x6Fn9znWu576v574V0BNtshwozzJ+FAd0MMwXheew6Y7rOaTnwo7laPLQ58+bHks3TOyAIaOUWAqHdZUDPHQkQis2u7X7cBQ6Enu9tdLlnzXqHzgKE0fb80weGh3Bux89FLaUaFBjVGj9tzEkGhntZc8B2gxuA54e/WVDTb4jlnLSp994YwWA1ltUrqOFvtYk0P1crbOLHD6Fjg/CmXBTqnZiOShvNwbiDI+Y6FHsgYNG/w+APjw8rtaP6XbK6RH8sIFmG62ARhU/rtlAE9Lr6zPrhYJg/UATXVygYY13NzbuKaDRRE+w4Vhop9CEKeXxxYh02jfAzyXkSGgi/wz0ReOhg7ZCM9kwnD/YEY2g6bjArW9WaCA4iAIt2ziNQkdGUvvvdip68ldXyCwMGqFN38ZxuDxvbfsUOpYBXtoHlPZjP1C28zL4/wByGljgSAnRvbblSINK9yDM9BKe0yjRlkCnvgNoKttdueRT6JutDYfo8zDrc3yQgKZ9FJ1xt60yaRQankVSaF2QHl/kZuH7v+H8jUa1dnp0qmA+RaD31NFPgJZLhadVtCV7jWr+TbmsTQcYjhK8ypaPfuDLctydBaB383P3YAjp71tfkuWrg+gj0FckzKmQgeYoB2+lMIuUSdXbKDTMhe7A6ebBaEcwGILdEh2c7Lh4kcfSwNh+eMM00lAoGpSkqCQ4FIBPuXti2ig4rYBGFpiZ/W79ebvyzFeH5uDQ9g+1vtt76BgptKetsxwGUzHxz2kkGmjGJ13Bb4a6R4m3HB+b4k1f4/nW0CXbQjPO15ordv3sn90b33H7tsrgp2aBpuY30jwGh0I4FY6yQKPvGF+88ae6AvhIA41DkuCBQ6GU7eAqlvot1WMsR1PnW5AulUszINDl5XIB+4UPClamg1vfoNCra1P5ubuz02t0KFxwzoUTAZnCL81B8c4yM1dG59K9LLNI2QW6RZUjCBsKceCoQpWbf9JhAZ4xx097V2h7W8QeQ8pjGGFA3U6Xs06FSOb9bfq7y9Xn7RXvRavGSmql7d6VpcD/56FxaDrEQOFECLU3U36m+jMWNlifUZrhqaTpm6BO07tXqiHGmLfvh3sZCHIA0Jkhm+creoydvnHlOw9aDnowjpYjbhYUsoWWNPSkVO1AoyEHnYPet67ejVLhbnx8/MavdcXx6UmtWheeKQetgD48oJYKjk86qm9Use5R39j9XFb40lPCy1gK6RL2WRhmXYkuzufnIGm3ce/u6sTUhDLR07st5szkWaF5AikpdLgZaKh26AG7CT1Pl5rfplPoxEe7WaJ5BgfOJDjPBemLlB+NRoVmHHYXhblKfKGQgX4JG3707ja3S0jb29hveD6eA+mtlkDvt41yZL695+10UzIItHhozy3XyZN2BBph7uup9CSRahbsNBpp6rJg+Y7+pWcr9GNUaKlxWCk7JppFut96WRY6jhdk+41CK7SHTZHjkanagUC/vT85+ZZqHHQsHM0tyrlw8cY//n1yqp4TBTTjbAFND/zcw0lgB8pj1zaKn43lUESD4QAPvV6YgVPhgq3QCwz0vY3V1Yn5CasS7VjofD4gRz1eIMsKbY81NwodbiXQVqfQBjqI6ym6O7phNqOkNhhoxJmA7ub5umCy9R8VoHFLyusubTmo8kHjN7i6wQU7vz5nvVhLoJfanRu3VzI/oNBc5naqdU7Huxerz6zRTHEy2dfTV9FIK86pjgeFabzOgo2aM+rRLNCBWzitXzJJV5z7sDdNVNTRaSuV5ExNGsQ+IXtoE7dD//x2c3j4EcbtUKBzOS50AM+L43/8CwNdA/dMPLtA40KKAyxy7MH8pH8ax1FChVZAo+uY0YUObq3cpsbKACp0fmoe9iTP7jab6Hx+jpcG8ZVCXB9E12WbLIel0HG9boWIvhB2Z3OwQndgloOHJCnpVRY5CGMM1K9jBgksR4TWX4VgJul5i2jU4h6A8plZSdjFm70j1r4rJPp5E8/eSkug26btdr6N54wLNGqzT6GlXCc1aBTdNHnotKXQRHJfMlnpE5VOi0pLAI/m35yRVoJxSbckmEQjCyTEYeR52NVoK9kfZ6CtZRR041sU+pE0CkGh7z/69GmTSZaQEh0Kx0eV5/gzA11tHMmF2A97BwZo6rUcHFfRcSih/rvyG8vMM34TzqV1xnmBuysFrnLgGiwGenVgdhfiHGyfi7KRYi5ALlgEmoiW69/mB6bk2EML6oT1hfA5mYnu7xRyjBSud0f5L2CGrqIXbmB167gz1KH5Qpb+M/Drz7cxmi8LNkGZgWoYn0skC9C+et0LuNS03zKc1O7GyovUd+TsbHH20HLwy6MGoWcrdGyJHbSU6AjnpBJosBx9iui+SjKJ7oNrHkD0z/xfBQPdwkd7mQBPZ3x8Sa/y1qs1b8KofvsyYT9fibWDSfEWY+3ctoocCVGi346pn4DzmCDNVTvw0ON3/lo7gfOgEmAGGuvOBPQhC/ThAfYJD0ColUAva8cBdqOM6owvsdD8UURjpxAtR353fgoLd5hPum1wXlM8Xw6YxiDP8keiO/2PHsOBEVArO5rQaymaqhwdQRo9cz4qQMO8c7kbSIO+sGqn7wyGHKBRTt+NyM56tByKZWA4Ih2VFjy/6wW29ptWx6Yyz9o56P3vy/RbxbqMT6FT1Cb0KbSucqSlYgf8JtXTJ09SkS1M98rZMHaGQuOZMCPToK/YPMOsL9hF2HTJmw6EVpGDZkNb+jxogJ60kObWt8IY+itj7Dk4yoEt8PHR9x/BbKDnMMENBXT9mFBmx6EEGrOjSqDtIyF76JLjOESiC38ovNmlKodSaAEaPYdV4gCeLwektpHgvBGB7ecZ4s+yOkjfkMUhNGELaJpfYEX88ZAXZVJBoWFNEMdD+bqrBDRkOpJJ2z1/TSkOrDfzOTAywoWNLuOjn/t4xmUTLtA0Onfl5Y/qc6DZPwPUMcwiZajjnXI9NFc6WjgORbPhuU/5aetsKAE8T4BuUmiYZxfACWC3TM5OttNzT8WXFh0272GNs1Jom+dBKzsKfkM0+i2VoXP4QRudk1g0CfT44k9vqidAdK1a/yCFOkhs1L8c6gcFGtBuVHdfoeFY1kSv05lQIY0HQxPpUO/iwsM17aEV0FC4m/64Nl+0PDTyPBfQGTpry1WCK9COg+6UGUhhOTIm7KlKtHeiOT1KHjqoLUcwJHOPQiHfyBiZZKB+T0hSSVuxiCz2jhDRkttok99Q+oyPDfTLJTgPPmtXsdvq/b6b3q7lcBTathvNCs3tQbIc6gMcVyyJxqNhj2WkoclC7ZUWLfAh+3asCY5ehwI0MO2U7Pr1adBqqvAAA1ui+4c3m5Mc5KCR5Ry1VlihR8VDA9NP3n/ECkdDuWgKQEOk/0OjDsOgD3FPIXRTlED/9xAMx+dl4rkkEg2uAx3HzExhxuD8cOGhMh3soTXQykRPrxXXC9pCK54HLl9eDTijGBM6pHHOjOno5LVY6nM1IVkOuRkeZuG+IKbDh3M0RMtjee6ofVMw4gealiDzpZQuWgS7Izu8aVbBCDYISajpXOjzG9u9MOoiGwjsO6ba8ypt++Jbz84+EOpcaPNMRgwjEcOev1KHIOv4UVp7aNJngFeL8y9MtHIdSVJpLmAD075ZYTL1C4YXmK63LtpdkWwSrCJscZGw346Ocg7aBprbKvq+ig7bkevIYdUOD4ao0KNc5MCE0r9eTdcaNP/5WOf59xBojpQeAOzE838+I87LhLRYDnQc67aRLhQeKqiLxWIeB/gD0GvzU1i4m12bKt6WCUqgzwMDq3lHoSlwZwFtER2HXchXE1Srpq0UuC1LnMjVNkBHldyGpBhHfppn0rmRDZlUgPdgFdNdeLnkdZYEmow0rlPhah2vJMy6nG49JfKyjkJvVyo72+15/lo+U5mIAJz5mnwH4QzrtbC6YfVUUtggjC0Znl2BhkNhT9LgTEiDXAPqFO6gUCkO8bAMRwobKfQeCuhlhHYS+tow729rW+Wws9D2/ALnTGgptBwLc2g4cjmp2+UWJXGHIn3nzawiGm30MQ7iEKDhwWx0daOBCSbFc3lZKfQyfCHQpTLgXCKFXp+xPIdS6AIfCgcYaPVA4W4N299sOIDnufyEX6Hp3QJoKOSdo39EU/x543fCbH5rrdBgORyFjvLGQZ4YY80lMKvqFdBI5GvZpinDZJjpkdb5562n2aznZbPZgOuh37Ufa6f0OfM1hYa34TngtxyEnefrp+BVlLQdGrUsNAqx66G1lxYjTS4aJ9IsCdAZHAUt6vzY8HxJ3yW8Tgs2rw2Lkb7pT9lp0yFFaGfJ96Y1BowPhXJfhWi2FRponty8Lz76xq/T4DqQaJzWr06HEPCn8+CXBqY4vtSru+A3QKGX7RNhGYgucaGjoFzHDNc4Hi6gQutOIQCtjoWzH02eIz+ngF7NT/iABlITiXArz4GuQyf75Vp4pxnjeDXcDmjoabNE4+AvC+ig66EZaGjGdKHjWJGeIA2TiUholMt2/nzdfhZghpe3/7tve3a+eiOVBNpS6GYPHeOAnUSa4aoVXrg6Q6F7dInjFxRohXcFz4Zopc1Vlp9TS2I5PM4/E9Jw8WoIp/UPWQOhuVF4jQ6G/lhSv2/AjL1cpdNE7TadvsqYEM0HwjEO3S3mlON4ktv8NAlAP1GfOz+9+litnZ42atVa4xjK0qdH9VrjC4bsjuvVje3fKLuakLbSNZzGxN9qc0xqTBNFUhcGY29amXtT0BsYLl0GwYUguBDPd/5BAAAgAElEQVSZhcvqpUI204XcDiQE2s2FbgT1wCzGKtPsiquWQFukUlw2N5TabIrUayncwizu9/583/m+cxLHnsSMTu2sHp553ud93vf9448vJ/eqb8tl4uYyOh374i1NDmJp1BsrQm2AgAaK3t1TRaG9U7jFgD5aUvy8hvxMgI7HezUNHXCGZK9gvt9pfxOicdsMF49hqaHbw5yJblYUQqjjkmJpScpBNdztuBud6k596oOcVOGa0PHu1N5cl7/R8a4u8Gwhoq3XF8Pzh5Jx5K+V5hD/p7eiLRma3Q1TR7sZOubR0MzQr4aM4nDoqtYGjwl+5r0Hw5wUZQ39SC4bdSS0vm4UNXRm4rZXdBjDsXKpnUBzl3Zx86E5Igs7ZmRNOMkR/3VOKK2vT/1HloVCdMwdNyofT87+d1KpnJyJf4in0vHly9mXs7OPM5WTf7w8qXyqvQGx8VWZHI5nJ56VfcnQjGgUHburP6qi0D4qFKrgc8x+OtopQD1YEHheG0Q8L/voTkocj6+1GxWh7IHr5l1AIDkuAd2uGjLoe/B5Nw+gu7XJQn31kXY0thMPr0hVgnNZNJ+9bQK6Rx8l9OSfnyeAoIXmEKLD1/fiQoCuO0s8WzO0j/rbXg3NEjrax11vDdLE0hdi6CFDREtI01/8d6yvVKLxFQs2JOV8kp1JbJBpp/B8zclCZzLGisakk4VOmvsLDJPD7BOa2SQK+bPFgUp6Uhsr5A1KgqSrlcrHjxUBPvE5MzMD3RDxzAjBIP7F3QbTM0vog/I+tb7395UP/XQXSBpFxyqx9I+7e5SHnkWXQzD0ERh3d6vHPx8XlqAgXAM8Ly8vE0NfiWsuB6X5r8jUXcBQHdBLjHOgg7sxlDgNs4xuwtAQiyZAo+pQ1rN2ANnvpDiYoYlh6xLBEs89we5QT6gpnt9FUml+fOn0BQG97ftThgaT4wIMbUXdjl0smoiVPAStXDutKHSRdER1WMR/oBQrYfCVV37lxrEqRCDnBpwLyLdV4/smsDMAOqk6hUmF6ImkWRImNb3R1aVLaH2HgaOiMWpHOjore4XQWdGe/LGA9AyNtOJ6RfmMDM4cNt6+QSz/vrBAkD5AuXFAaGafYxcRLah5/wBIeve+0BxcFM5W0LYrYG/l3qfq8U//bIB+FnjeEXAmhu6lfXXxXi9Dh01AaxHS/nCYT4QzQ8ebMDTmR7txKIvkNVjSTh2oAO132uSXyRfp7tAATZUgqI0QPQDuIVc9CPycSqckousXBvQ4NQAxuWbxy2Nz/LmGjnJ8VOPnaCyhiY7zGPqVDuchwnsJZg+xcV7iviT0Uh6BgH4k7bocvvXh2Ju3M3jGO5NERMtwksyNatOxY0ltOJZQTaadktDzHoKeonnCSSLoLCb8J7NZJTnomasd8gINxDNMAgqiHhwZGTxsvFkAKC+ww1Eu7wNHg4JG1bGCkgM8jpUVwc4I6NVVCChJQGOWA54lAegnp9VGY8kWAnrNBnoGQOsXJ3AvgVphwGLCBHSYZ7XCzhgWMTQZHRjg79T2c1yWO2d44DBk7pYJqraLnqT2K0BvdztRO2RownOoJ+XWyO/TEszpcfi4YFG47T2W3WTfluVDimY72oz1K4b2uhzRhCM3mvjQhoZua+PikCQHUjRBulTqk71vIOgc6WfxLRJ0jnac6zmODDN00wXnSc+CmTE3oB/qm+3kzDdPFcoRWTY7svCCvncW3vBs5Dfydxq2RsyD12dnZysCiQLRa0dvkZrvSM0h0Cw0x/S+fADTiGbkaNVh4XDSIAP6VhUTd7On3xrFom3jKPhyYQlePtkZ5HFCcjnUisZ+F0Pjb485/UTJ0AFaqBS/cgVPqej7OfANeKbryUG/sYwg5O/2B7XtHVxGSkA/N/Yj9YDcgHGtv2+5U0av+5CfJUWPb764MKDT49LhtSiS+agFtnnfhtn5xlEVMKExNmqpjUi0PwYKu5KuN7ROocPQIDra4HnVhtKDUh0RmGIRr3oM8TzOZ2IHAMxUDOak6NBuXmVAcmBNmEk2mYx1m9AaoMOmhEaGVh70vHShJ0lGM00L3ZHFZxFe2F7ZyAuGtklijOB7kMe1xff23vRCeeF3RDOx9L54TwtEs4wGjl7hdqGgaBAcq6Ci7ztFYbGwV72FTvT1X759O33y4C5Mggt6LqCGxkFXDnPwFmgWE/T0e3IdDOd+jaHl5bZeWCYDx650++4ywrWXlpNCajTYo+L6dMxe26/kYmhorLCGJobuAYb2hjI2EynSGyli6M0LmnZuhgbhYZ1bHfrcgFYuRxOGdrqFCW/n22ToobahNknRV2VOCSi6XuJ1d3TsCvCcQ0yzvZEzb6oQP8Od2MyETEE7ETu3yaET9JhaAuYeKZSKYxKmsFQ0CdA8mUWrYxHQTIBeR6vjaG3EeEhDI0Hr9WCZ+BnAzCTNmuMpcjMUhuRz7Dmtb1sAugBvCCg9+fz58+ldMKAFZS8VQHK0hwOacA4HwtpxQi9DM6DJ1dA0dEDNcqtD9qaYpo26nnV2wRBG/FsydMemdsab7I7Q5jMP+24hmH+ANyC6flETmgBtDbPTYamVnudUh7rk6NN9aIhy/Gb1GRqagxyJZq1vU0OT5GhztcBLicf1Uh9vhSTpnNP6Kbiw37gTBMlRADNr6IyCs76fUVl2SWcHWH9YTqs81Fxo3NaoGt+UTZqcmp+nZmEW9PNkFhG9zvy8uLGRzx9X7eLOzk6xKHFti2dtbcRuLNxZAGouLy4uSBE9zQF/khzA0EjPAs8goZGj76+qqe/ZmcOqQLNAtAD04OwvT359MEt4LiyT5AiEaRGjjNwFFFcjor2A7uIVorrLoW4RouQY9UzLXqIRLaGhg359oCroxzvJnaaIBqEtYxgpY1tBT2rbazA/38JTm6Q4BKC33nV8B6ChD2j5pOzAxrlltbSjdQkdZcUh98o0czlc0VG9KNRb30jQ+GL7DjvgjxOJx5FEjBJKZNYJkSG+xqkWvHZtYMB9NDZzk9CsXI6k0s9uCY149va9VeMb8naq7T3vFIVTDx9OCWpGCc2zsotOUZjPA6CLjeOvX7/WioTntWqtVmsc2XaNCRoATZYd6I1piWZBz/uEaEwoiR/QiwaadsJJh4W9vcZegaYMYW/jvbs7S4BoKAoLvrDcshHQU9GUo4u3d+kMbapp3tgR5r27cjPpKHXA5ZpztfW8k259+/3NmoOXTUCPhvxBibiY2vUFX1tNIkbv03iFIvUDXQuKPOvo+B5ApwV+0/Aih7k1oFFzAKZbuBwWozqmr+OQzZVzGfoVMjQ+ysRjjo48TuCI4TBvK9BaKQhoPko44CgOTo+yyzExoViaAe0+TqgVhUknmcQMrRQHJfwnKZ40T85dlu07kB5g3v00hYDeWMz/tVGs5fNzAthM0LVj8UOtWGygZVfWnoPpMkroclliGkl6hStCRPR9geg9XUM3BKALR9gMFyT96+lpVSAaakLU0LQN2qFoJafxtGwThpaXstoVQ8uplV66FzvK9ynoUhB80GGKTgXooAK0PLlpyG7xWxJxfc72up4baW+p93IzncLr3iyi0+86vgvQArxpPEYlkO2zOGTUiqExomQ119Du0VhyOVQUOpY4X0MzQ7eR7EBE18XvIkMDpIel+0wNQj7nzUcorvGlWHTtsD/IND3BFwmbeBxjRNDa/oLkhMnQCGddRIMNDevtJJizAHBREQpBLSh6igANFD3XEAw9N3d8XP0LAbrx5vjnvwlA18oLd+5ojZUDQLOAMsrpaVkUPpUUzVUh4Fm6HBWhoQVFC9GxbKMpOHPvyedv/wVIo472hdGtc+0alTevFEPrDXBVGWKnMB7WDifDsOwoXkDu9HRY6JJsCEyOHjXCHZT9buNXRwWgJRW/p2gd9bqbyI36jVSKDgWlAdTb5+L5+abrjz/0CW6GuKkMNvNS3JYpJZ/hcmidQgzb/abH7aSITpRYcsTMzoqhOADKQNACz69kZRi5+jhS5w000WEfaGgZRRoASAOSc7gTWkmOm7JTSPfpjW0cJqLH2OXo944TNmNoJ59ESM6i5sgSoLNZvbOS38jP1Yo7DXhYclzfqTZqtSoBekGjZygKxWtaCI992fhmCU2W3Sp87DFDD5JthzY0zhXSc+/B528Mafahw55Jbp6tIluuKUUHeKcSW34BdbCQT7jR2cLeS85RN8R5yFxxjkZ0UObxdBEdkZBL31DbchMvPRdg+eoVSei09f7leZfbtiy3Pf0M+yjwTkcFtC2e4Y62SilZUbeGtjAP7U5D69tyDcUR0dKjejhJaQ78Vs1kXY1Q7i4aldlnB9EkOVx3Y0lxkOxggs4oTE94GNrAsx4d1QYKp3SbY3J9SsXtiKXJtMtSJBpMO2DoWnHNLhZtrgkhqAw/2jtvsSJ0ID1dRjA7PvQKRZPY58Cv1VVANAIaGiv2YaOGCtrGZqQNDiGQ9L8EpJeXlkhytHu/4vo23a6WD/cTu1B4tNPZWIlq86ERcBgh5EewrtzPSMs5NIoOhdSu/W212fyGa//+i2eRlPakU1sfzr1EWE+nX7tnVRiTaflJmxajYOANezUHdhFpPkX1WSxLQ7OlhfuV1kA4u3rfPCerSPr/nF1NSFxpFvWvSmJhaVUl0dKKREPAIiaRdgRldAZmEcjGTRZCINBOyMLFLFIOXVCrXggzYJMwMBB6NaApaGZ8tAOzy7iKFEQZlMpbWkhjUZseSLKYxezmu3/fd7/3nqanX1U/Dd2bpk8fzj333Ht7D3vdY1gaW4ZnMA3OiKa+Cu9IojUcMnk15u3+4uaghKJBVqPcmI26dqQ5RG9IdNSm+/UOg8fiQy9xZ2VBiWiGtKsKDaSPG/Ww1XB4Hh3d6jTq9cbxEw1n5meHaKgJK/Rlioa+CvQKv98LqtxYOek0O7W9gPGMjRVTGwJL//dTJxRAJ0C6LzFEGgc0KW01JzBuL8ciMyNvp0lBD+MyO048ZwbkD7TLABeX0vLSnh7LxtsWz5mdiLlxD+XGS0H05eYz4Ll4Gr29MoMVIX2l8TeSG7nItUOSJkpni8PDc2yUsFQs+iL6qodnZ9xJY8VimutCTHTg3ArfjLX5Z+mnjPE2jjG7AAzD/dwrXJSi0A/3Tycw9NTdf0ZcaGmsuM730tKmS3M4OFNnxQF6tRmGH9uturjQt0ZvBs1WuAU2tMIzEvSLKEMLnr+vbGBJiGJa9nIYhjbyBTy7Ki4/D4IgDAymq6OPnn80kP7Qle/LFyIsjfgeT7ut/pcB2hDzFcXQSNBpWw7yQeRBC+gU7hrFPB00/lIa0MNuL1gPbrUjWTGTyfwKh1b0qo13pzt4kvCe6Ody9vLA6FuD59hRtx1AMmIZ8YyBZlwhitf/uhIpGsPRtL7Agtressp5iE7SHCWb7/cS0b2R51C1wGEtqYRH74iARn+DKFqfoZDN/bPyoWC0BfRd3VNxDD3tTDtR0IqhHy+ptB05HUjP1CbcZNFBDgc+7U7dVIUW0PA8bT487oQd1fcGCb1MMhqb37+0nRULaUL0RsW5HI/+cRKampDwPFqH4ZV1IzQCCJDeAuUhDE1N7z4ZWaFp7p9A0Z9l6EF75FsYmo7Rw9igZesebA46OPfIxApStET6mYHP35gnW6arsYjnl/A+/0xONAHPbw0971L+g/HML7wrP6EOFk+wD0375mR9QTZ3MUPT/YmSFh4RhnZbDGzrWz8q04FONJ31vj5/R+gZz9RrycHrvygHPSug1nj2uyo+Q09HPY4YP9N26CVx7bg4XCM4m18cQxtA39xqeYAOmg9+1zSA1gQNySRoE2qKZkSzcQfxUfh+vcEB/6+e/9hoNEKoB6v1sNGocYX4FBR7tVp/3tVXyJNfJx+XS+JfJEiKm2WSNLTH0DENTXoj7Uy7Yc4apQZSA9Gr3ep6twX0mzLbdgTo8zKLat5yThR99vYz4Y2dOJ77d3I4sGUJWuq7HC3Qd0fbeAKKk/4TiqFJpAgz2/Z3McbPkYC/z9A3YgxNXoelaDJeHEOLW6ccDqekZ0V4wAPiY2oq3lIhhp6ais17uzVgcUTPaaMD8bxpgDznK46Vlb+sNrdu3t/auu8xdLvZCFsgOf6mTQ4jOkxZuK9FB7yoHKxwxn+D4qOmKPzqD3/+0AlP4HpQtfHpU6dWox6hgTQqj2oXEXNagkmFtHdCiOE8xDfeEriasO4xdJpls/0inrtZckAiKTUgx1UchIf9HFLGCYwzhi8B+rR0z85ggQGNiD579znD+TSO57dFA+OXlp9nBJl4vIKWB9iPz9AazUkUbTeAlYqRvorOJiUw9OFr+wE/WmmOEQT0PN4Gmh+bRxN6kRhaodnqaJophOY3vBMQ7bt20wVPcRBBqwHZxzJTuOT0s9UcvoQ2kH7QbBhyvq/zHPe3Oq3QFIrHuqdCZeEyFoZSEzKcKxbSjGgB9Hff/vbDxx8f3RqtbjVaHQIzo9pAeqtuAA0MzcJZ4qN2BivmTl/I0BbNCQzNLgcAmpreipxxZX9KrfviKNJA2QL6nItClhxHak0jl4NXf0I3JcbgMFGL3LyLUy653RxfSMmOxC5qTtjxb8PRgOZXTkST9M66A4RaQ5fiDF1iCe27HL29O0dHRz8c9B/A039wfnTUS4Cm1kqui0e85w2eiaKvL9JnTBiad446hubvhYDOC6SnSUL/SQ+rYFflmVcTsscxN/d7BelNJTfW1uaWjOJoVDHLEQSC6SqYx2FwEnReaJ9j34hoqAodRbN8dpimxgoDGtyMv3/74TvI19Hwd81SNCkPiI9iYBSGq8YLIjmGEsE8dAGg8e63G7RlDZ1mJZ0ejAM648QG/SHDizdkFSOs4LBF3sGZlhz977J2qV25jF3C0lH/z3hOQW3sisGBDL3rrvbwyR59use2CXO6HpQQR5FXF8jW/qKH6PiiRrcJDPcY9G7HTPbDG3ZNh2VoSSWRAb0IqI48dxnRLDkSGTrvZIc/7+02cqgVBhrQm7rrjQy9iRJ6bgGwPLe2YsC90g6rQfPTcbv9MWB+bjSPm83j1km9ceyZHKieX2C+HxFtFLXW0Busob8mQGNV+PyPzx8Z/RyENYYxwDlA1VFbD9a7cINuoeAiHDEcp9NJ5aH6+7jRgFefF4ShFb7TslOJ1kEPo5JODeAVCog3ay0ti0Zlc5Kj6HsZseWOeGcS4tk8Z4n8fHB0eZl4epXEhi4KqYudeFczl/P2y3AsaTehGCzaw1dZe4XC1oR2D5jeY4AMHVNECOhrTNEjbETPg8MxLzoaNo7i1lFn3LllYITkWXKg4wztZaHNb6w4nMnx2EkON/StNcccWRwgoxeWltbWANUrmE0Kq+Fx+1O7/R8G9M2GQXf7Fx9PyIn2CdpoaEAzio0nL25XnBNNFscG9Fa4KOQ5GINnCJDWapaa8RfMcpDO6BtPS/wZfLw+zdN0RXYosmPXlyGyKrrgVHNaS2o1MUiunBs+Gc4MsASBj5qHdboYKNpqaFilIYCmH8m3Nosz2UuF9RHA2CjoXbv5ICe35PFwz4TsHc/mPDSrBTOxgJ1sLC+Kz8GjV4RnzOy7VuE1T0X3xjNV54cSjCYnegJdDiOg58m2W8ReoYbymN0OTRn/Kf1JiNrl3aLzyJ5znlVRnW889q3wzF0VMO3WNm33GwF9HIIDAbPZ4nPUw877v76X1oqmaIgnATUbeE+i6LhdsRyNWoN/4zuFMABzq2rkxh7kOb4gSFumXq89dbYdgjgPYY18NNQhwykO0JF/AsdnOdSRwNDjg90yMcgsTVXgcArnTxSeUy5Zmsk4oJ6XNUP3nys8G2l9kNTlLpbLpcsA/UaYeUZ2eRBA7dWebORsWzxkZxRKpCAsqiubqlsYHVgpOYZGRGOn8DD+/6QP6BEM+P+a4MyDV9clbueLjkWRHITkWdTMs/HGt71EkZdgkufakYC+mKHnKNCxubDghUfBtauHBnBic4xWb1aDMAxP6ltfPrS9b0Dzv5ZfYPN7/8XqKhSGlSeryxVbFVLkbgM8DmZoRHSd8PxlZ6/mnnUg6BrERwtpd8mtDy9tXsl7EhoQ2SeBpCRE065d11gZH/QAjQB3m5MQ0MDCSM8p806lPEinZMG56vydxQBdZjhnksa7D3Iz5fLZ6Wf4GQ0ONqK7xK3IsYqGVD29pEEYlRy5aAZ6JFt0n4tyHCUtoa/ZDaSHvYdH8bmFa9rmQIY2mgM4mnDMYvrfY3fVYjvcbIe9ldm7rDjMa3yK/opF7ab9KHRsd79tfOtwkjY6yIUmSK+sUNiuFQQtozHWBdCjN+uN9833YR0nVsSLRr0BySRmaJAdTx4sVwxHg/AAct6AJAca0dQpxA+Ug3u1vVazU6tpxYFDhV08QzXuJq7yXh2YFnIu+PtII7eTC9avGxz0JAeGOwa73W47bpykMJdk0DzcQ450KmNJmpfJXO33VfTrd1YeO4LOJMWfD7Z3ti+N+b+RhQe75EEzQ8vzKktigy9vy2HlSLAfXrtZHRuVG0EJ62X4MNBVFtIxhjZPVPOfvpb2t59PmrcMzTw9thhjaNrMYfEM31lXEtqlo5E950lLZpCjlxSitepYQKODPLsVznEYQLdbjU57RcKjnIhutwIk6CeKoffRhQafw2BXjOhKZZL4eYNgja0VCifdwnlYnMESQFvjjuyOLtsnJMmB8dB83LOL9lT8q/ZuYiXt3DqPoWUpBzO09LtBcViGNgo6lZHC0EB1xv0XRhXtNMi7ckYuYH3egU7iZzKgX+JaGoQ0S2jQCt/QSjn6qrOa8ZTdbnRZUrHohEZJL7MTe6MUZ2jX+z7c3t4+P3X/PtucIhVAjyBD35mXqvC6N6/i3rN4QZb4WeQz/7THgtTslWoTimenrnxz3G7Jtb4jFE3FIesNwvPq6oNO0Fz1AN1ptztB5/ih9eyeoNaQrB0x9WRFZlbomZxEIW3Qzi7HKJ5GBsGBj9IbxM80sdInbh0WeCShh3wKZoa+chFD6+iodFZ8Dd3tFYUCaFAdPY6ah/3FXyq8cT6TUaL6tGy3RJ/9HDzPqGeXNi3hohg42QOgfoXU/ArPnLzKTsQZOmsRnZgaLdlNSQ7ITM5XkzW0a31bYXXwAzcLMRI9AtMCBsygocGJlnT/dagNvcYKBUajJaErC/N8XWV6Ok7Qnmn3LGJyzC3FBAc2VjhvB/RMGhrzox0BNM7HAqAbgQgO+rFM4STE8vLKsxU0OgDNk5XJZQT17X3k6f19y9CPHiGgv9jjwcLfeJqj9lRcjgK7HHmLaEa1NAoLhciCjgicEc3dQ0Pd4j97rl3M5bCANlVhhlotcL9+2B2mx2NXSlUeRQDNDP3/A/rg/AyS0y+pSQhpDpi+4mgSMOE3hqO5i00vCu9PxBha2jA+QyupIYpZrsYyN5ecy3FDKNri+X+UXU1Lm2saNrGxao758jQabc9prGEqxxFnRPCgprMKupEDXQQEoc6Mi261jEM25we0KHYjdJmkm2GkWXRnXRwSDiRhCIiLWSQnSDpZnR8x99fz8b5548cTY9MKUvHiyvVc931ft5pQaHYqD4sE6Fq9UADuzler1fzpMp04lgoVQysnurhRkMcGPTeKf1ciY9Fp39EqtxmznFD3cUgeh0nSXXP12ql4O21Eo4JeMg3+Cs9bjauzbovrKpM/vXw2+bHb6J7lGhm7l2MdtcbRBZe+s79k0eZANF/sJwHjpDhSByn07D4plwMA/RMzNEKaRYfGdO71mXY5CLCAa55IefDAo0LojWeukSuClj2xLD6UZ+eMQqdcpIBqgkaGBiD7I6PByGjQfewJlWo7aKar6nN66eZ9Ad3Mo9Q4Vvx8TJqDKysE6HcJImf8oKcBsXNQRa3ydrWMejTzm2NdCh/ZOykshlbKv1kbhGvAdRlO82HVFE1ZcKRl/kpmCqmjY8PrrWhx5vsZ/LC7oWO8/UptCiIp7SLokxNT+rYFh/tSqCl6l1MMtIzOZi5b3V9p6Hvyp8+fnz09PPv16qq1k1G5SUjNmR0uE3Kd8BK77ZLwIEyD+jjYZw+aa98IaIzJ+8dLJTlyuVaj5RAdSNncyzFEe+gJ0DHLt4v1jMY6ES3R/Zw/Ok1EjIiepofdnDSEu2NVvi432gUZ0JEAPIIR6eRwY9ruXy5b/c7NOck8vzegrxNk1R1HSUEfS1uSxjM7biCdT+ViiLx8+t13E7I0UFZTefb09zh0HkdtwJLOpJ7mpPMmv4nUr+F43Gqryy/i6am02qqip76nfnzh6cbXRWksOiZkabeKEhzPPfo4tOA42bNaR3XOjNWcpCSHwjPbHCijs18vu4joyc//e4mNF91/XeKNcIduhZsA6J2tLWFpeuwDllcRyADnVEobd3gnxLqKMDTF6SoBXeq6RPRlozvATaPjktNolrhhhWX85kmVIWe2NK/BotlB161wLDw9ZgBtM3SAvDtyo0U5LziSks7tbfQVB6BlMutegK5eh9DYOLY5Omq3ZACci/j2DuyYzwtDRznPXPao0GoqGgOYcMxe9dh035pGfroBksow7MzXQjOCRXimd6RK8zrfz6GpfgAAp6ekOYkRTS/ee5dFm3GxOaxMaLoSao9jHPCsy95f6EbI9Ly3vd3b3u+oExI/L9nBSVvYFs2qA14DpK8w2Q4I+m2r8fWfGczkkFyOzSOW0f8Rk2MVSRokRwpJGjCdQudOOPqTlhyTRnLoK2GJ7Wjs7Og2GqUB7B7lxfXTLDmYpPnFLaNXQxaep8dUtB1Oyc7Oml4O8j6QoVW26KgNaCwYDjOsg5FIj+jo83tSGnrhXoCutudYZig8R8m3kwGUUOhxsdix2lDLnbb4duw+yxZuAnSI/x7tnbpK9MwOKkPjkfxNORxPekZWauWH1Wb5Rv88DWBOS1//lG4ljfcr85fjLKKteULeVa8E9Pi4FXP+xfY4lODYdgBaEM05d8BX8lcAACAASURBVCv6qE7o3a0VUNBZ5OhMZivb/TiJq1EOu19BbGSyO3SOdug2iNdBKnyTiAZIr14kAcvJFMlo27g7EMlhAP1neACiEdQUafA3fIl23tmA5meOQxpR90ISHjfjWRD9wPbmaL5bnzBLEiBo8u0Cfl5CwZIjwq5dhMauIn6TK2pTtPey+eaC5vJE+R54FjSrT9Rnpyg6VDzv/Vb10ITrITIjam2pd0dwWLPdjx7Z4BVMM8TtNYU8sVKrXXcqt/w4+TjimTEdNzkG8b7mZfk9SY1FfTvk1d5KcGCmm7uqYsqEzrrKki2i1zjCQOQGQXrFuhXCQUw3rg6fvfz4MXe5k2G1oXvtuIuDy4RYLlxdXUWGZpIGek6mBNApVNGqUjiJgL4Sgmafg5i6VGoxYXexfXTcNEBzToFAemT8BkCHLYNjmr05kNB+Ffw17AuzfA4Lc/to4DtgJZYTJ9NG8OGInvaWFcgG0p7UU02ovRSA6LtncEiyEuL4WOuNeQyIiRYKnoWYZsEqaTuQzXCOqlK304Amhw4w2xalrIlYlwdpZ5DVbod3wPrtlnp1AuuEiqLNDNbUXx7egGhX0ft5zDRCI6CdHof09lse9LbzTrgmgLYGChHTIDeWFJ63GM9A0pdnh4fYwJGh+yALaHhu8kDh0eY6Wx07O0DQq8nV5EUKlXTqIqUYOnnBMlq1jypAf+KAO0R0rgS3Q8Zz6+tAzNlgp/mZ1wTdytCmQEjlQL0LlgAtdnR42jcNgMZEjm/0RApOFAo945i3+hThcZVRraXnvS87Wmx7hXX0+c2GMOnAMLSYHADqdrF/Y15dWjNCCbvGLRTN/y7rVEI9MdCI3bZ7MRDBW5Zgqa8NFuvlu8SlVuvLy/E0Keg/sY6Wue+p074/QXVjRjt2i1JUsRLtQEOLx/G7Y/sVIvq3m10OxdBC0KyfNabRvMtmMLbxYyvDaGZIi4ZmF5ovhPCaGRokx8U6ADqZSqGKTpLZQVb0gUiOz5qhS0zPdDlUVfBuixmay31DXCmMjSiijkmsjIf0oPYP6zooMylWsld4bHooDAQdBlCHkaL9pgU6otxm0s/Ds7OziGbf7DC3lDoo2pOB8yI5Fu6O6AomOs7NcSr6MbdyUFf/t/UbsVTnDjqzrZiiRnlf7L8nrKBRkRymjV8vbnMF11lUTWWVWv6uaXzvsLc/PZU2FK04+sfTPj/1Rk+zXcyuESKgdVXlix3SSBT9G3Xa2ZJDQRqwrDX0rhpY0XjO0jOTyTTOJrHsTdQseGaCRsGBhzAN5JxEBY2mHXwCPCepnyMF2CaC3l8/OOPgL2LoLsC5e1ASQKuC4Wssr4iGVs1JYnJwS8cNDC3VcVkYZFr4/TqRHwA9RPbd0LTF0AFD0NywgRwNWJ71+WZ9mPFIiHYA2hOvdRmcZUS3Ow5IlsvlDjxdMK3OS57j8ZyxOOZDheZtb/T1BCOWe0snzOTghJWSFHJWVB5zNdB2MWocilRzao8ntXyzWrlTmHXluj1ARZUpLhRScUUjOu6diJ2XisofFUU/n7E8aA6Fdjba2ZlJ285GDsT0myU7Csw5gUXmBmF6F6+FGaDoy7cIaFQcIEDYtWO5sUmSgy6GeEhxIEXjtZCYOiVGR0p8O5fkAES/YkDrpjv07l4zoEd0r51SG6KlafOGB0fzsgrefsXFwLBrHYXPOHeSdxehJRSm90h0NKCZjT7AMzwjGFGKsA4G1MUw78XQC0FF0mnQ0sWCdaRzyaUjKtE5TnNULA0nUbjDlbLS5hWYIXE6Jiwo65xRHk15x4jWEaOAWAAxApk+6EHhG6I9asXObf+BSrWcB3UNpziPjRzxZSqtEJ5pGEtZ0lNe36n5nkLu2LizTOjeKyFh+b+M6G17WGXbNI6u0eiVSQNb4qKK3Ae5pKIlRwbwvLLVeIuNSWhx/PL7Hl4Mj1Q3xzrqZ0IzMPQ64PkCNPQqm3ZwK6SWu5TYdy5AA5R1M0epZHWRAqRJQwMT48aYEWmHNn8iFcf6JIFZ+V9kz/nGfG5AIz9Tsx3zt1/t2tT3PswvR+08hlF4lB+GktqPTaWGyIOjPRxdnTMEHVThdybVUY6zD68awnxSzvBgqp7P380huUbIvsOupcSpDWez8CokQf0az9q2GyReZlDj50GCNosPwOmt1Nwp1J5wuSYRHZhfxtYkVfpmhjbO3YemV2GF88+t6vdzArTy7LQJbQX3722f6HkVuxHaa0JWioR6SNZS0BnUHY2zp0/fXqKG/nnvZ6M6CNAsOi5Yc6CGJhUNDzTukgJnouh9A2jU0N3LV6VXguec6Ohc7g857ocWhh55YDOz9qJj/bzocWNxiOBwhZz7JI5DndleQNPUigAaY9IlgVQIOqC3X/VwdGfBuhQuyIhhUI8aMkPPOQFdCUmeo4J0u35nuy/huBPat0MzefXY0S+qAP3IwHmQX9BLUBrnt+vmcqGgm5NC0ah02lHtWxjadNwBpj/0fgchaNkfuzij9hLqK+G4uhI65MbeyQkHj67pOyH7dKA43qyZuEZ261a45i2tSbvkQhNBb2WzqKHfXmaRoklGU2WFSJpcO1LSAOlVhDTJaCHo/X1VWUkZl4N86JcA6NYrw9A5u80/l5MdKyO6ohKzXI7+DA1fMQ6HwrPPHdqPHM1fBsmBDI0+dCA4au0cDBCgEdGzlO9oMXRgVIX290yltJXiWAiOguTAD+twWIcb0OeIZoEzio7bkmlsv09dC08TPeMpsmHTrhSqvjrWHIxhx6d8p3On94ay1W2H9Xbg5h/iMoBFgMZk3R8MpHtawztxSvPnaqFaffVcxy3F8XfvbLRTywmN5rCj+12lbwT0GzE5VqiPg8C8q43oLbgUHh4iQ2d27HshCelNGSgkSF8QoFcVQ6f2U1pwGA3tcjm4WOhCNANaM7Ih6pgske0FtCmpSKeoc6WK3n/lR5Iesqa+/f7AN1YSB2pprK049lZQV16EFIdhaDghew9FfcEqkdPKN3yRVk9vyVGY07noxM/3yEWv691sKDtCiQn3xli79O1kaMle1GewVqjf7DfbxJ03/f2EZ2ZowHOa51XiL16ovcjk3rlJ/1zmvrVnhwz914o++eK4Y5hQOXYE5z2qqlgZYKCg14wDzQSNodB2OzQQNupneKALnQGGPkTJwUUVxvSRuHZic5Btx5IDEZ1UhW9C9AFqDgegcSWF0tAMZka1BrTy5cjWUM+Y+YcRb0CjaYftopyJNGYTM/8J2PUPmxwwmsLCvbGjvDLIih3FwkpEA9rN0FqdPD4n4+L/nJ1PaNvnGcdtx8ofqbEVYYvZcUNcx5DgzDaYJCqO7O0iph5MRw6CgoKzzZdCB4lFNXrJZZCBzcCiUNajbMEIcfBhNycwISGQdXDQcjDDfxAWhpKD7WNue58/7/O+708/ZXGUxO2yrB30k2+/7/Pn+1RLhwdioEmig+NWEU9n/bcCLSkeINRn2gso4ZqrOaLZ49yLJYVetiyHpdDcAuTPaj7fqP4/02z/TtJ3vwFottDRMX4UouEYmxXTob78zv2Ll/8wiTdXZLUQPq7RKjV2XskcR8to/75zoxDehBkrBAwHk2ikAzjGlMa5hVeZFCt0IpW4Cwq9XkfHkTYWWpfttESDfQakdZ0Dahyj08OEtMdy8KPwyNZoW6G7pTco/tkpQrdTaOyY9OLVq05boflPlbkgiTYJHVDTCwR80qFveBQaW+ABsRwhjXQodJBfHdLX641CG5x1TCkptJsFnacUD3wWXtk8055LdZVryzpmw3vS2x1O0o/C/gNboRuHh4cfUW5u2L8Ny1sW0Eg0Pgmj0ZlBeRBy1h3lKHnewV/Km/DXejIp11JGeS9d750FW6H3F1ZeLRDN+2SiM9JXiUkkxyO6vClFjvsrsRTz3Pyu2awvwaOw2Wymm0w0TvcnaR7aNhxYix7Gmt0w8DwMQIuFXpxfz9KjUHnoeQW0+jH/fJ5HlDYcoIneiGfd+xIPkPoA3avLG6jQlG7uAbqLqtGdhmiuUmPZThekQ3R4U7e+8RpLuFMUOmRqc3KnMBimroz1CoQvM5ZEi492/ulW18Rw3LpyeOFsn9pQn8Z5SAOt1LaWP2AHQk2VZS3Q3Ci0FfqDSewlq/3j/MJNfcgeLUfHHQxOukMbK2KgreCZnxxvXh3UlmNSW46b0ZIP0TtI9IKTMLOCwm3njiqiWaJj7DgE6hjNjZq2Sgr2CitvK3DfDTNCKxtHdWoVgn1OYpB/XJgmjR4mhUamR6FXiEBry5ElD/3gDQKtPs/hbfiDh2jtoXXdWb5JB6XNKuF5WlDxeQvyYbYA1DQ6O63Ac9r8ti10UI4dU9z5jYvhi1KGDujEGf6V0FikZowzvoSFvXG7jKecdKvlKPFLETR67axbLnm5vs3Good7ObulVXuOwxpNGpI0RjQb7Q/NVQ9X1/JW98TVa33GHqLAlEJj1Q4cB4yQRmejhmgeVbLH/HcPB/Vh2S9oYRamklqr+uVfXpnbsdJToTFSGUyCAsf9Kc3zXwnnqYxzjUKahHPKbewtZSeyWRjxn4A/TmQL63XUZ+p6p9Nx6aqwRGuYn6CRBqJHF+G7Qnp0+vn6CB6l/f57ABqInt/AP3DlzgM0ugtMGDUg87eIH9DULiGePScJsZgsJ4EM0Qw0chrQpTtIM4BedzjQFb6BTloZEFgFD5tgdPUtjOuGXaEwPxJnmOaZkGUzDNL8MmwBmtOWzr5Xm9cLVXxSvmegZtXWmOaeAdP6lmUVIFop9Gq1bftvVSm4+TeG2/NUQFNMDXpoygK7YxSaeZ41AbtmpKO8+VWU72JJLNjVm5M3by63/l95v7Li5BfoLmFxPyMCnUGaMxxsF4uZZUIZtHs0Z4l0s5K9ns0WrMDGiYkKHPpOKolOJuPYV9ECTUVoR6GHkWYkWgEdT9XXMcLgweNnLyuVjQ0l+Bvzptjhp9AR5lkkmr9H2o1BX+71V2gAGne4laFQRGMPkU5hoUKTi6YJ0gAGgoHDUE6aStJhmikNml1whhrlPGjUmWAOzgQtqK0BD0V4q0KTQO+elWe0HGQj8LyrwrZmD4kMWFkcnlsqoNHnttrNapQO19CSVNtFSjaGtEArnm/PItH8KIxGLaJn6WEoPJcby1HnsCzFzeDc6K4P0LZAf2MOURT3AWvkeQp+ENHUJMxAxS4mYeecyJG622ymmolm82QJrxPC2aCJ9bdv/wjLK4VKvV7frm9vN+8m0vEkj3NAm1CbaKEZBDo+jUSD4TAK/bcfj49P/nOkiEae5z373wZofd8q4v0eack359vel3t9FRqLyQh0wE+hPwugh2aJDhjLQQWPsCwa2gpNn3AILUcYYJ4hprFMF5wJjXvsNpamfYAen7k1/gnJjnlZc6UahgM0EM1AywpWn3OM8Jz/33L3UG8SCtC7Lm3VNQ00Wo6O22MUZBC17nzbiQZ6iLTx0DmWTJm6k0qe1febqz5A77hjdroKXQSqMyLRWLwDpDMxZ3JUUjnQONNnKZtd306hUC/tbW9vA9nKdUCAEqyWfJfWbRXuqujGCir0KAD9l2KKBFqX7UChv/7Xs38en/739KgidQ5T7QDzYdWhL3X74Ox9FPLcNCt0C82OQnuAvtzqoQO07K1AlS1wWJiFFUMJvBOFDrgKTUxbYXjGelDtzs9ygEJvfgLQ1lYgGenaBS/RrkIL0ziA5NdGKdW29FGVtuN+1T5dVcELt3fofD2k6s7CB1+FY9pDRwf/xL8xGjlzp8JYaK3QLQ3F3R05HesNtcugQBfRcJBEi+XI+ARDz9XhNAQY5uuQXJCGm7Jch97GE0L0XxaUVGt9fs0ajWVokmg00Uqg76UR7EWrDo3THI+fnSiV3jvZO6qgf9amA6E2HrqdQsuAkl2DJoXu7OxtuUaIMx00WNdOoQMhubvJlykoRFfWwEN0tqKNQtNKLW3WSqQ0l6VnLLT7yj4KfWv8oHx2oGu030pXqRDqvqq7eks8uxsrQ3ptcMsvTIGnleDT9sVY7R8aeDFAlzevdHCGvxJogDlKPRXQ6Vm4+T0Y5e2e6rdOxC7fWUHHMYmrKt4az492XNKC2yQsojarH9jzvm/XoRnpR+ShQaFj9+oF0GG87K3egIkUnrFf2oPHYL2yhAe/s+Cm39alr4IWOq7n+7WNxiLH4hP8uvh6FMp2ULX7Fap0ofDm2cuXJ8enpzQHTdtYG17LEfFXaKcWLY5DPLQX6Iu0nEK3VLo+81No5jkkEswWOQA7hpY4B0WiWxRaCzUw7hVo/TI88Ix/MNFrZ+e5nHdXt8F1ePoTa9pEtxxvU0R7/p1Qrf42v4qTShzb3344vw8F+kWPvm47NqZ8NBsOfSV5lr9xQa76UGnzT8s//5wTheZQsKucB73p5zh2HAdtrgVlyG6Qf0aJzrCJJteR0VNJIND36so5rx/BYe+jo3ozkUgkCWhoq2yrn1VeBMI6sgrouJ6InuaPUWgGehFn7hTPqfQTABoFemSk8AbvXhXevDs5fX9a3+NFLO4b8iyHCcb1QzoS6XZMNPe9e1sN9EUayODrQG0UGlkPBXWYXZDEllxz0Py8ZTdYq3mPNqyZJoWG/6kMKFmPQxfoAw54vFU6O9AlHeHVrw9YKWDd8ZJDU+UYMMGMfLrNBbaxZXfCIYvjwgeAfjFAGdSwmXutA3gew3OFs/ruN9KsFDu3yXvhym08rOLulRD9BQn0VdoljHr+Ljsr3hq0IpoiZooA8zfQXiGcKV8GPUfGSDSXoR/N/V4pdPaoqRuFqUSi+fY6KnSaeoXJ5DYctFdAJ/Wj8LUwjZ1v9NDTVLTDtRXlo5NxVGiMTvp6BDuFwHQWriKfnu4d8QApirS2HDxyZFXtfB+GJljmvM+AHRONN+qxyMHNEvN2tB+FUGUOQIB/SHdZMN+A7LcRaVehg6zLIQ22tYEITXDzNhxvBRqZ/gSgG0NW/AAn3Ho1ukYbK0MDnhv1CmgL/fLh6pqhmT302oUPKzQlgeAlozE8YQ83v2d1jUPZafwPX2m/MZhrlHmbkK7Zk0SLQEc8k9PHoM/6QVgED13U297kOdSfZHCynyQ6JruEU9Z2LJbr7u0R0LB8BZMciUSagOYVLOio1A3QjkbHwXKQRk+TQtM8B1loshxwSnYEOoXz8xsVYHrkwbuTk5O6En9d5+jArQUNcbdugntddLcdxyFrV5c9QOOOoAbae8D7ou4UhoKCsDS19bmVLvIZISuL1JJoRJqOshD2Lek0QbmjHAp6gb5FnuMTgN7SiaG82/o5Il3zRIV6c5P074HPt3b1RiA6DZki1Zaj8UGgewRozNNF2zGmsGaJjuaiuZwimqahqw+VBZG/XmnZNFaA5kne9nZDlv7hDCaB2yjCNz3AkUGYMxlpE6JAm+0rqwz9dA4UutLE/VjcWUlQlSP1dE4nGCRZof+cTCe5xEFljtcs0eI4zIeBllmOefxsKJ1WMq2sh7bT6wj0JQCaS8+89t3dvnJ33iTZnW8BWla+DdD2r+iyqtC8C0tB0PYBoRDhG+oSWbbsNGUg4E+GNNDukrg149Hn/GPr+2SFLuet1Jh+PiuviK55Twa4uaOmbtePOUiNhmEZF1cE6NKHFZp57sAqx7UcwAxfojn00bPqz6K5Meb520Glz1bnnCWaHPRVvkHxpfs76BSLdhpnhXIRC3ZkOIpkoPGLHAuaIvMsk6Oc1Kg+expo6n8nuGw3dz8mo0ms0PE0Af0a34NxsRy6CD1KX2GWA2UaPTRk2xU00PD5gc7IFh6/PH5/enJUMQodIVGWUSXzUxH3UahfhP4KTevb6lEY7moFWjvoUMs5FeGZ2ohAK0g05pK6Gs2ehNsureFhVgvc13J4Z/A+btauz/IcHEPQ1+e9G16TA0EMNDluUPRz/TzRcU7WsbY+Buja0IBN9G0F9DW0HDn1VRlncNCKbOVAatpv/MYuv5SX0UNDjYP0GYl2PXQVi3YL/xaBXkCF5oYKkYydlQy76EzMWSakOgfx/JQsh+J5Koamg7e+0wm99/33tCh0Mm4UOq6nk1ymWaCfWAqNw0nP5xdZpeGDT8TCOyXTJxWj0Jc4yI43r0SaiWeZx5PkAlq7+h9n1/PT5pVFweAGbEII4FAm2C2ZIIFApFIWdQUMs0Jl4yxGIyQkKlggdllMLI3Ujf+AoIziTaQswdl4JiO6jiJhYUXCXrRKZ5EFlWVRZTOLUZezm3d/vXff+z4+nPkc5ydRqXQ4Offcc+8NAC0LNlIwnJJGDT3kAZq2gBHJiuPGZzizln7ZxBZY2z6hW/FPf5i9kqGXg33pFtBwAu5T8dyRNbjIt5N51hwhoG80O5FNYDl7GYiGCzWiB3oA9NHMtCzTgxWoBaM3CgViaPMGLJsXgBpFRNPojT/6puThN2JDzxNDo4ZuBoD+sL//gQQ0EDRkSElyMEM/LK9Km9AOquimd9GttANA//L1FkwTbrGGRkDvMKA3leRQrh1q6DWK28WpDgb054Hk4PcJ8rTRHv80ehpufY87aUHL7RxDy48T/lmsz7CdDa6zr5HlGTFwzqQyY0M2GioMPZJ2nJyVN4+vZMnKI6wiagnZWefuOaOPIH+FhsaEUig5Fj59dxho447qZcu6I6TodtQM8VorrlloPnwgTznSgUA/g8lxdbTkSKKqvPwULjYDnKtVxLNRG4jqRWp5X67f+TYw2Q9Rc+CwNzE0APqbpl8TKjjjccLnzw1DrzJDkwtNRnSRs0laQu9VeFgF51WQoXnHDCb8maHdVg5haAdn7hTSEJbz7RRLQ78w0NCaoaEB/v33wNNP//2OJQfjdtCl7jyKHh6HC8rjtOcf8PwZA3ooBHR6DI7EyvY6YO1+n6FZAGcsNdt5LBQglp89YHtFYjYtCjqt4tKB5rhKciwsLHU/hZ6POqpNImu9iKLbMTOAjqK1LYJroDkXzQStnoRW/LGNXjOeC1UAdBVYehHVBupoks3d6mFkWw0ztFHQ6NkRoF8GLvS+ykFTPUivVSTpMsY4pCy0sVHV+C65KIcB9IN3v27RtMoGrEtiycHJ0R3H0GuP1+Ab6423qDUY0XM+nh1D/94D9Imk7nhiFvV0H6wZ1Qw9ODzs/GhbGArSZf3Xrdi52BRfILTpfQNnX0OniaHTVkT7AdExq5CzVinbH5zjIVokLds9gtY3QXoyFtDLPba+YXFA+4gjo3Z3eW6yY8CMiM7HAfGy1ul0Wubd0YWkANpWhBrRraOEr6e2AHqKAY2IJoZeBzyjiq6R7XwY1S5sc+DBIFEc492wrbJPHN2wkMYeYRmI2YB4tYx9lSLAueyi/VZDV1x21AGaBEeEoTefOUADnB+tPVo5cD707KxFNAtpwvUTxdB/ckXhyS5EondVROn1q5ChucHiK45xu0lpULnQ0blYPtgGGFvG8SoDaJ+hR8ipQNsuy8eQM1GGZjQr6YG0nKI3queUmNJBlgP/2yg84hgashzHPfS+L7sdA8XgUApxLi2Kmcxd0UJv4svwZc3dI8yj5Gip4UIH50SGvtGdFjwzRVdZQ2NRuAgdFYPuc8yTxOAZGdp8YwEdB+jz0+f7AGlAc4PR3GhgfKOBUDbPQ+mqFElGu6dUAW6ueBqaAQ14Nsz8TgN681nI0I/WDmSHwYonoJmmmacjGprLwvouT69IQOlpH+zOCe25UHAMj9uacIKy/TdvBntlnIbOpDgNbX421H8/lqGV3tBWB1eMAmOm5oyVHQLulE/KmeWYcFJo23XsSOHSUczIyJuu3mJ7NhPesNKr96HMy+Wu+7po4t1MdRlI14Fyqx5wnnitizTH7VEh6L6qkdGmMiRYg9yorrdx5Vfs6C1KDor235uQBWBdP8iB7HwKkDYv9O0MpMuE6bKBdxlhTU3vctFbmITT3hU3IasAzbJDAbq0tbPpikKR0I9WVg6k+02S461oDiU5Ihp6t15HNANBi/YASD/1GTrMQruqkNeD4eUKuOV960pAC0sDQ/fH2HaooflAfZbdaLHtMgaqzuOg3omGctraz2O+S5fxM9E4+J0LGyuM54UZn8raRzBJNT3dsaG35vG0SxqF68vZt8hdP8Z1lNN3VFr5gfxA5GnlW83EHTd02BYB3WcfoztQdKzfWV9cRwv6Mp7nWUMTQ/M6jnkP0D8YPEtNuN8AF5pENHN0AwFNiC6W9cJReoCcK6Wi3L8SyUF6A7yNd1ZDb21pDY0mx6PHj9cO2ONAk0MztG9z+Axdd1a04HmXAT1sfehxqzJiAh2Dw864EwkdC2gYprKAjvjQ6RHrbjCelQ+dYVNPeRxORasCMeMzdGY5piI0P8RoaBorXJq5UBMjbZfOl01KzejRzBmfoXP52vVjAhd4ZdAeBmq18l4xiL8wv31+HaBRcdy+bQBd6Fs0L8PRBcL0OgjphE7RxQsO+BtAW4b+zo/aAZxPEc7PAdEiObgyLPNDyf4yx/rlXSJIF7WGRobe2Pj7RgkxbRl6E9+koR8Yht7c3FRGB2eiFaR9325ulydWYC8H0DOAGr5/QoDmVUonT9GHFhALO4+HqmN4XMwPkhzXM/QfQHIM9cc2VjIWysLQadVYEQRrg0OZeBnaqGQoejlecnDkbjnbvUJyLCwtzdD89UW7VhtV4yajM7RkoFlTePavzluIHvdQV+bswnPe2ajRTM3CViKgLzrTFs6GoQuFzuLiYl/HILtaNYjGpnctgeHfkAkNfW9D0czQPqD/u/+hwSmOBrUJ0YMGUl4tI55X97dd0q74MELQxNClvSQNjTb0pmt9v7adwjULZm3boYQWSNfh7TE0AtrS9Im8SHKghg50RuSng7SvY3ACGRojdTFt74jkiOkUjthpFY+hacIqnR6TxonFsQtu8Nt8WHYse8WjFoSdXQ3opdujcEllRk4Lus1HNG/YFckxMx1qaMob5XpQHDfO8zl9XpdpZQAAIABJREFUdGKAdLSQM+mPfNI8+EXHfIKTM6OmQJ1um39KpmsX0EI/73bbXBpW118kfCLN7xDOxNCwFDrK0M3TfUvRjQa6HA1i5zLo6G1A9vNtTG9wWfhQ5aB5IzRvTfIATY1CzHIAoLc2dhRDA6ANQUNNaD1opGdH0XNBnMPT0D6gyeggB48ZOkgjRRl62Gfo/rjkqAX0GPQJMzCC0h+VHCN0L9Z2B7NB6ztjzyR7BoeGOG0zcITsaQ4ZWIkC2kD5bwu0dlQuWk3RKQm9a/GYuoNuiDuceiVA15q9ANp7vPOagOccLm1MAHQbtiU0L9rnzXP456ap1u6+IUSvLyaZkLyXAxy7r2Rn/3zA0A3iZiJoMDr299mELrMNzZMqlIQuFsuu7V0pgeYo7RVlPFZJDpbQv7qicHNnzWpoA2gwoP+sWoUHWBOuzEYkx1+QpH1AU1XIzxNVFaKGDgk6WhRal4MZOmY4VmnoFCuDVEzr23zLZpWOtuGkNE5kpceytrXiQMxOnf1VRp819CX0VQx93lH8vMSnVUb5MqHeTTd5Ji26KxiaIko9dGeabXVYMx/c1yQ4A6ITvjSOcbF/7J83X6ATvXiYpMDPfseK4+69+buC6Ak/a3dKJjThGSQ0Axo4mqIcNmjHIQ4uDEuY4Chvl0slNyJrGXpjw2PoHU5Esw/94PX7sFHIUY5Z9djmSv3J3GzdlxyOnU+kDe4kx0QConXIwzH0rWSGTuMeaIPtaNpO4knUEknrcFI6kw58u6xW0Zah/cUcy1HbLo6h3wA/L8viUTpN6A6y6b1Hl87nmOauindEE2VEL4O2R+JwdERCW4puYVoJUJ2v/T+3ys0X6AtSHImfSI0Jen7+7j1Zbz4+71WzPzRIbzTY4YCiUEyOhq0H3ayKo+gKQtroDzTsShrQJQvoSgUA/ZHxvAVrwADQX75+bxcZKAXt9IZH0zC58vjHf1FRKAwtkK7/7BwPg+ifwLbD5Rvj1zL0sNPQyYBOcZTISI7+m3prdGoIjGjK1Ckv2g4ZppChrf3M01dEyyw77GBtOhMTGbU+dCygF+RokFwnRMkxpXYQGGizvdxso+r4h8xxa4bO5Vo9bEK4aOGlWH1bs5W3TjSQ8wB0HH1ANy8vewN48wX4HIsvEz/6W/I4vpgHSMcD+jddEG43rIJeLXNThRFdLlOXkP0NCHCg6qBLbpIepSzH17BnhhL+xcpHB2gYWDEM/QoZ2sb7V1xFKE70XFgWPplb+RkD/p9bDV1nEf3+R/I62L17TUUh/L9ey9B4uDCRoWEokBiay8L+m/dvDoVlYZoQn866ojBtfWiZWMmqAIcy8bJZGyTNZjKZ+KIwlqG5JlwSDb1EB46Dc0CjHfu3znLT/uYY59zletn7X8sxoJ2roTwOOU1R8wF8VDvqbYa3eQhN8EIiQZ9VCdAQ7W/bsyohoE9Fblg4NxScneIoEzXLMg6DZ0PNRSwJZXH/3tbHV18+wHASRpN2WHIwoMGp29z8+Ao1NA1gUaNwbU3wHAgOF0+q8xoDJzmEoesue7crGnoQ8xzXMPTgeC8MjQs2pOVHDB0C2iY+xImWIcMMTqw4+znjuc9UJ6boO/PytjnGIDsC6OUFvkRx256PFUDbq0CdMx2kmHRT3JMa0deCrmkqOZ4FyF3RT8EV/t3Qouh5+qA7VahWC4eJn8gl4fmru/N3Li8soO9pQDf/AxLaI+ht7qgoijY/KVIwSVy7PYZ0ZQ8rQ96XVNkyfPzg3a8Y7afWCi5Q+qgI+plrrACiD7woh4ST3obppLoMySqXQzVXnOb4qY/Q7JorcY0VezD5GoYeG7s/lnKAhVVIwccRQVtAZ3VjBTHM8SShZ6+/wqnoNEgT2vkYSdotL7ubFAGgyeMgvTG1NDXqAA33JgjQwcmV8+6xF29mROcTnLKzS/PUarVWbpJPA1mHTj/YZWn3SMYxa0u7BXySc1YviZ9NOdi+cWEvE/pl5G9QCAYMXaaut6SSSHFAR+Whm4w1IK7gBt2KvkLBgCaCJkSb3/nrL5K1My/aOMOdQuRoiHTI4iQVIFX8PGsYmn3o/5F2/TBtp2e4NudC7Asy/oNjYSjcgQQKJTldqxAhq8qCykIiZWBog0BVhHTSZakluv2Wmwq6SFEkqx1ts1jO0KHDibTCshXJ9hBKOmQwZ1lGTJHaGzrc1u/98/31z4a0n8FBHIY73ZOH53ve533fR1pyKECrD4XsIA0dM0PRfmo6JltZhjP0vOVFow9t+3YmQxuSgwP/gNSwVBtGgRBsjUg0ojqyaOpHVAbtVtyy4SBAS88OLY7xicqE0hskOko1HzCV9ARzydADHI5Gu1YsJHU50c/a0Igutdo4Kak2hJQbbdiBVOp0nKHS7SIH74Z7LVwmvLu43RjtSTzb86EbJ9rgQDjnENEqlpRjKANDGzHoTenbkdzYVds2L6n0DVG7r/Gg5Djb0hT9FiUHAfpbuhRmVcPK3Jw5ykACWoiRBd0ky5Kj7EPQOzuSoT+JxT7xratYpe/YVRqab34qeAeDcq2EPwA6KAFtVAqVDR0KayxHNKRxYgGPbaQ7ZcSOQq/gPVDZHP3Ng90lxdDS4ZArrRjO6QEzo1voRScyCtD+gfxeq5QwA6N64zFn+y3F0cQf1mpNJxODQ049rBOmW203o52ayadSeQHpoYCuTRJBT4mbY21SKo6fW4D+TgD5Pb49RYvje4A0FglzVRfPObNEuMk0jTpajtDdvBB8PPu787Pzs7Ozd3guX90RED871+eNAPTsP5XL8dC4FRp4Xpvb0wwtAF3mPLQGNFod7xxYs4amM4Sh1aKK4QwNhByV3jMCd4zG5PoBOhyxshx4yYNMqBHh0AXCYDQqNQdoaFg3FDaj/Stm91UYCdoGyvGSYmhCtF47T3AudQc39vFqWAZ027cRYDph+XoK0LaEphX1rQYGmMHXaxb6BHm7LiNScEktNNwmgsNUSqajhwK6JQm6IPBMsSTx9uFPFkNjIokcaKDoHOKZ9TNx9DcK1H1wBkQznpmjgX7vHB0cHB2cHhwdnZ4ewfjRO/sHxoHP4KUwm6WEf9aqfFvlb6dS6DJ0+bdfv7PFNLgckqKHaOiYWykcwtCB+UAUdmjiaNxwMBodAGhD/MqAPxt6EV1KpI9pKziAGHfeg2XXdyFc0aEkdjnssIXB0ADoFF8KpYx2xsaIX+pa4NYTzNAEVz/le5xMGIVxtZK+SWB2NEeJGloLTSGx3VVYjXahKGNPjXS65IjnRkuIjZQ61wC0UNBTvx4tLaJlJ57vv7YA/V31pPr0pFplPAuGRkjndCoJIO19gxTN8Q2poQWSPRhdIKN2MI0DAI2jc2/tz97aF080Tpfmf+3v4wezLDmeMaLX5OxRi6JlFppwzZfCz2/ZGvrdw/KOTpESQ8fjaNzFBxcLOQ8duyZD40JYYml08fwBHQlZzSohOWkGpUUU/Dv9CEVowC68CHzqkF5iqCSHGbOjP4r2OEVN0FwlJEBTf1On1heiSNeMiYlyKn8mkfSbCtOVA5UoijSSNCrdDpqbTVIQvSLsKey6w6KbiUolzXntbtH5x43jTso4+eGAbn+BDsfdqfvd1l0AdFwA+rMP7/9ifVH1aVUVCZmgq8rakNyc80hxeIqgxV1QYHmVroSr1qUQKPro6AAezMezRy5Dz2LHCl4IDX6eU1NmzEIhKw9O2xkMra+FZWnbEaBx+2J8KJxVxwpQ9BUaOhAMRHkbVsDciqV6wlX5RQ5j1CcKegKsuSAWWehTslgTiujdynI+jYFo1tAK0xagG3K7yhK6dqnlcYlmeErU+lObWrHACNCMWgXk43B0p2mcUoLhDIAeoRWyDppHRkhBtI+TRZ+tnyBu0pU0/2hnK2ev1IFkdIreBZwFQw+zoWkfxeJUsT16OIWcJRD94f2J/UUgn6tVfv7ekByqqLIqBYfKcHiE6V3yoFfVpvrNrXUA9MHlOWjoc5wFjVdAGAutzqXsWNnIUsvKw7W1rBO2w2FgpnNXLvtIjnK/dSckBwHadjkG56GvZGje7BYAXFtwDkT7AR224RwKETOT2YwjedWc3aA27UI8sTRiqWh7b5AL6PryypJhQy9LhkZES35uQwIPFUWvY3B2I5FRKjrjY0F3p3EAR9Jg6BEqCTZddi7igP5Gq1nq1h03RfzoXgcn9Rq/HPS/Q6E4bmb9JwDVw3271n20oKEPXAAafhHDCjcH0D9UjRshUTS/SZcD7Q2dgmaKFtQMxUJPPBQ9bxKg999crF/IKMc6rka+vKD+2I1vOZw0y3M5BE3vQWklu7YH6STSHGuKm00V7bocyunQmC5/JEPHrqWhhawIBMc+pQ/GPrXESDTQx9BBiOsHydEIhhQzI5ptigZI49KsEIyRVh2J/RLaD9Dd5RUjmUS1wgqjuqUaUifGUxOdts+QZonoTNEXz2qGYwLhnOSkRh9DE1B7zdKxO6i/lJALLyqCol1At1s8n4PwPM6KIzWTtwrnvWNTkn+FBI0K6ZDwHBeCwwH0j8TN1p0wZ+pnyG/kLDxT1Ru8OgKzCekLBrQ+/0FAP/nDBsIZKoXGsEbqwEI0y6jd36yGlTmrsOLYdlJylHcMV/r0J/EbCOjYlRr6GlkOY/dmAODc53/QWk1lVQNuBV4R1/AnBKI1bUfZ6lDTPsgHRPVCk6K5h4tWb644jrQF6CK1qwiGfr5sxO00nuvFNInqot/4Ilkr9OXn6STNBpMETQzNXd7meVCnYo3jD9Z7pYxc3wIUXXKunfUCrmZOS5cxNU7CIw89s1JF12ut7fykUTTpgeK4TRMcD4m0Fu+/7we0eXKaoauGC52T9RSPioT3LsTZ5d2EBj8rhlZZuy0PGHr/cgO7CZ8gqlV8lBgaKFpr6OyTDXNaI5a/cRRpuQ/QWC7UHF2WPjT9t6LLMVxyXCPLccXxY2icFsOTR0MIcSBpvBUipqP0NfQKEjNYwoGdFeboJU3O0SBsAYi6gJYSWt0LK+JpfIIn1HVLyNbjPoBud9RMUX88U0GQD+VCzUZYZW48wMxTwREb7QItQawkxFslk+702eGNbgIj24fomKfzRNKgofMzM9v43Rq9EvSAT3btuveLGuc+iLOAoIcC2lQc+kYIz16O0Iz3wH/BK7H8zWj2pIhmht6SHYVbW2cMaJxgAA+SHK/Os2oUmJAce3ssOLIbz6yiylx5wQI0uByPJUMLTP/iN2cazfAZAPQNlBw3YteQHNdk6EFwlhp6TBUTMZRB1ULSy1jYDiM9ByOsqsMoNuQgSPAE54HowyoKwpdDQHRIoDmCawD6GfqXBqKZpllgdHW2o+1TWBFwrgzSGwhouAhKsZH0AfNIs4DmRq3mmn6tadDmFcH/AtGZUsu3HN5Jc+AkD+HtPJE0ITrfhbbI/ItfTd5+Mblt/F0oKDyPHk8hay3efy0A/aMPoE9YdFhg9oibjaqKJ+SGgPXuD/BKuBCu7irBQau9MblhBvzXCdA4wABFNOWhb72CMQYPORG9t7fW11A4t7C2kTU0x+81oB+dKoYuv337Tt8IxSdZQ8uRur7BJHOMwf/F0FpDj6lqocqSsshgiDMtk67GOyIuMsTWXByMMA/BEV2aMZQGrNSCM28CulZhxSHjdnQ3rLAE7Y5j/A5ESN/Ktx5mSAfwcw0bq0amk8mRoedBmy6DPXd6Qos3ApCCHpCNRgWdzosHyI5DiJ6gij6Ea+Gfv9rmyY2TVvfK5BfbUorPLMan4lNwIxTnHy6gSUQ/lYID3z1TcAA5I0tzCPreXwnQMgWtTbstCeh1BvSTdYOhnxgM/eaP955lnTg0QHpPK+js+oby7KhjhSXHI8XQaHNILV02NfSVIvpGHDtl4zf+V4YOWKMcg3S9IxcO+6mYoMm3i6gHRzloeLoAs3jcnKcFndGonu8RVskk2Ko1D+82oGXH9/KSpujncuF3d0J8PPEcQ3iFfoKmXHQl41MhLKA/R6geMdSGw86E417bjj63Cklz0YX4Mn88NzpCWI8nOhmUHWmk53GurED9G4L+0C17+9D87i+Updf62WKcboQnAtAvB0kOTyDZs0h61SRoDxkaLQ585S56dp5U0OLTW/2XQgI0xEc3ENGYh4YFbxrQ3LKC9saeJui5BWOMPwy362NoFh3W7RA19JRyOfyAzJNn1KBGtKEHtWBdAemAdvFYRRvtADIaGqUN4PpgGQXwPHYTKXqev4nGM1dY5JUQ+dlhaAPOhujg+2CJEqXLlYnlomsz9zLpSgUkh18kqdaEtldZ354eQM9SRdSd10pnJFFoidMdGOmoYSq7Vq/h6MZ8WqgN6UQLPBOkYQi6qaBHCwrPtfjUovg/uYj87DD0Swbz3z1PMDRCWjx7LDkAyCA8PHjjhJ14vzcqJYe3aR6hOS7sS6GgaMXQQM74LjtWcHQSRjkY0czPVFZZMKJ2QND6UvjYAPTOjo1nZGjx2yjel4e2pzcag0fjP1U9soGP4mfQvzfly1B8KMhSiiMYJkgHDVsjSu0CoRABmqeBCB0NBI17lcP9/d9CQc+7DK2GzBiQXpL+hlQgAtEunrEVCwS0X9SjXqDFx9NSOSNFWxq6Way1G75D1Jtk8iWS3au6X+otmKpQw98W0P+It0IkaZ51NwMjOm5Pbpt/JfQPLSE/x+7ChfD1ic3QLw2CzlU9Zmj4w0Ns57wvBaK/ZHb2iKb/jS/Fgoon74NSctgMDYB+xS6HgjRp6HOYMcPxUeiQFbfBNTdpN7dg0DRVCgVDP3586kgOO8tBgHZ96JgeX4ADDHj5lWBoBHTgoxka1wcFrD33QcnEYdoX9F/Wzual7TyP4z4mbWr1p6k6qQ+DqGDROnNx08E1ZS8FLxnKHjw52MMgDNRDKwgDyw/m2tAwMiD0mERYQgou7KUIwZBQSHJoaT30IJSQORZ2+x/s9/Pwffh9f9+oW/tLa+20tQN9+fb9efaw0Tlm5ulkAcYzFJo3qlP13DM2TGui8XEoNOK8IiVa8awzebaJreVAn0vOeBAd9JQ00VjkHjWQpqdD4aOeH6U9u6O3LrMLVfj4BBXMC5y74+I3Eb1ICt3qMCiLc1eY4RBEhxT6BMwGOg4WaGmiUafvkUJThmOXupL+YIVWeegNrhPaQAc99GZAod/LziQekl1Pbq7qqrfabrcqgS7y1HdQoVXyTqXvEGguFLo2c1Cb3Yg8TshAD32R5YDzWILJbk556OwyZzlAnCkr7QWBlgp9ja9pUVDJh8KtjbrLynRYCp1VI4WMtOK5pDx11hbLJuBcSnQYucpTCxJ6DeY4oNCNfAdaWw3aQAMjWBePELbbLVZo8VcKng8wwTGI/XYH4/tyI+miW+nzcWjTWbj94RfB8wdLof9FKBPPu4h0hZFGqn1lnv30o92/4rvJV6TQjwzTsREMCi0PrYFeX19Tawx2aPvoNi+aWQ/uO9+WSM+w7WCFnjWA5kksY2KWPfRFCt2n951HIlcAWluOaz2QxOs39tJQgq4fO56V2+BfHgDOUaJxRlF8NqhauOdcM4N5O7dCE9UrS3cSdZJKAfJwlkXazplVPwLPjWYHU5CXw9wdzLO70/msmcd9HDhxePG0LWShCxroxCDf4TQUGnkec/9t1e8x4r+bYcfxj5ql0D6rM0aFLMw+v0Pm2fc5wUHPn/RHTQPN+mxlOTYDHprmr7SH3uHWpDUawcKgcFW+G24gLbqDwmCnf3HrFNN2C517OehExYi8HwsHZK+i0Og5qGrYbXoKTNXRm2BtkLZ8wH+H+y2Q35iTMSXm+WI3UjdCi8CIaCvLAScKs4yzeDEgx0vZUlZoNABdslt9zkZBoI87aWiNFZo1OpTcaLkHARpqkkXI9IXrEIR/zuAwel2u9M+I1wHo9PRwFwONFyrcX0UKGPKD4XiBjiOYhv6jUhYcl8Wr8nNll2Va8uz7UE5h8/yIfXTal0AD0b7S540NS6E3teU4RKCfo0I/10DvQLFQBIVqn+6MnJbVyWhpOOism6XQKs0Bi6LZQh+CQkc69nLIO28j0kNfVaFvypEs8Bz9ZskQy97Y3h+T6uwNeAPiTQydiDcHyefuOUxyxNiJxORSmVBbdLD0XaXN0FKnFc/VSTAawHNWepCg4RjMd/a4bcc+JIPnqvsiQAO99hQuMHUfATc+o6It3K4nkM7Jlf4ZpdDCcBDRePzbvWsPBBpKKsJwnKBC/xa1g8Iy5DfIdlA06DPSVFoxokFs51cKnTaSdlKiTcuxaWU51slzaKD52Saiadv5amAIy+hP0gr9d1uh9eD36dvDWWk5OqWh9aJzfbT+S4JCVuhuqdBet+EpwGd46mBQIG0X6/ZofrYHss/dc1BX6dEKLaQ4tezeOROyHDQmC/XCOys5HRCWQKCz0oMEeS59PIe44ylzzsq2G20nzrwCjBW6eb7haH3MFyZBkgXV/DsxcycUGnLR08PD++yf7y9OO/9H6z/FYTiWDMeHE/EtpNA+Mi202fdRoSm5wRK9G8xviFc6/dlQaMnzBgaGtoe2CyvoOqSH3pHjKuoGljWEJTMdM9pD8x6DMNCUuTt9K+LGrnhkYSKuaivXg5dk5eFCmeYggWYv/P9WCoOfBp7KcsiFMh7aaI8cB6izeFEPEwaKdDNubo6qhER0qNcupiXaodDyWVkieKsYCoqgUACdcASEg+ftK6g1pONwSLSrgt3O0fjKFE8AFM7d99huQraO2pUSCf7qcVZghcaQcHh/WPI85r5G24zDDl0sqeDrxAL6M9PMYSHZDN9XMn1PCnQaBRr6/NOvAgqdNKi2LceDTQ00dyatvXtHQL9HDw1vVn+U3UlspOXAt2zogB+K8x0sR1G1KG29PIQ0CLWPwh5zfGNfRu5jhY6oC7K8G9q5H/qST0/PAH6L6cODtA9JirZBM/7U40wcn0GcU2drIcmxLIeurH0GYQ+tkB5nH1EA5yxoFn4j1LLZXFq6df4al15Ocjjk2fEHq/mGsaURt3KcFwrmb+GWG1xFpgwHNv4B0hls5RiGRf6L+/fhMPKZu8cfJwnHoESIAl0JAl0rc1BIjsMgeVezTS7aT5ODTv+lZih0ktwzlAlDloNMhwE0BIVvQKG/Y4WWpcLtNezmEN/nZ1zrdLFUWCTLYQEN7z1F03F0ijwfYlDYJ4mWp4ECCg190IbluBnpvhrQ1DcnlZmDwf5+q0BoPkCuF0Oe5whnKKxgUSUWmr/qqNBaolnOWsN37qB9zi7ZX/zrzWz247kGt5ajuRTHE96FXqvmjBVg2F861dvIndXrtWitfuYopUwm5BlP4TiaqgiOP4eGDui3E34DFqALB90hxUHrksBwuBW6huGgT4VC9By7imJw0NhjB/qMOOPb9H+jWqHTjHQ6yUcoOntoeO2sPa787kPpe++lvLGyTkSvchPpjHE2SHx7onuiiwEPPV8szgRU+gj0eXb28FlXfKQPshxxeMX5vmafff5KpaG/hkLzmKEE2osNxAZiJtEs0FqmMQ3Xw10aHtVVqNvO3tHY0UPLG1gYGtLX+vqS2kcaUtSPWbs0WLMMQpVmYXsbjk4kR10wuEZ3VC7smMo381Oh6kvt+Lhwa1LuXE80jb6SDEp0ZvDgYFAoNBz+hpRdxvm5d7YPY7Hff/ogaT6xFDpappQdew7EGG20VmjUZlTpNCC9m4xGTcuRRKY3NhxAg+GwLcfDT58eKqDhgR7SVd2hNBPYQKqK30U1seKyHOg6Xh7uiV88fPlrFw8z9Enf0Sf76owsx0hcBoSY4/gKCm32Q1Prs8Q5QDMzTWos++i8a1Q+pJ4ke5FBTHoPB9B30Eov8wLbnNTs8JBKNdeu2dk2C7sWAG02cMjFosfhD1YwN8xwlgNdx2hH61Grw249dNCK5zYe9sxA753A+WAQFLoLDlOMZdwOGhfL/IDB4AkTbSu0Tx76Z/QdPj/kPNBAI9KPUJ2hkSP5T/ln79HefpboENBwO/ZBEGjxPE4+fkNAv9/RhUJshl7T7aOr9qaZ8z006bPgeU/wfEQeuk8wPTERp+jwunEbuY8mVeLxSHwiIon+ukB7PSjRA7Tz32ZZdTExzOimb5hD34YoQ0hIcaGg2uGh+fQmEXQ2nkrhEvTji4t19dxkwy57o0KH/UaI56b9O9Bx8D3a0Vy12iHVUTurHkPSTvNeSNBa9oxwHDiBBYdl4QDnmNPs1xaJZyXQFfE92GxX9svIcUXxzIZDo01Wg4nWPEcxj5c0UnbwNhAU4h0KBPo1zqtAXWVt7Q2XvnUnhx78xpBwfnV7Zl43KD3RvRzYneQqrBy9PZxFno+2nqJCo90QQC8ooEcMx4GN/YJ1LKpQ3m7oSkDDHIpnKDR2Jg3EzB6OMNeAMm7lUDTHQr3QvAXMAfQNqc8g0QRwM0VAX6KZol4olVq2g5Y8N87zz7VWMzj1TQINKPfihEvu3LhTAJ2zGlkTMLgyPo4t0UKhwXCMZc6c60+FlRy5eyBQfnHCCl05qQUV2ieJFliXLYXelY5DE502eI6aKbsNKdF/nn5nBoXSQ7+WlsPIQ68HL32rDdF2WUUCXcRejjDQW+Sf0W8cHT192jWhUhyCaQoMDYXmBTNxkOiIkuivqdA9MY8EOlhQQYpNmcYcXb913NBeqStVeNnOcsjUXkr8AndBZ1LLAumlSxz/iQqerU0H0kGHJDpnrR5wrbXrpdkWfM5dt1g7K+iPl5N72aFQKGcKp0Gf3QLdhpQdZOykgcanbik0BIQC3bJkmaC+56sfkGYMB019RqCZZu04NuBuLAC9sUFpOw00FVY2eer7m5dvaInBjtrNMaNXJ8kGUmNfY1EDbVmOra3TZ+A3Dp/9KnAWQH8r83YTEwsRSt31BRQaG/sFz/GIJdBXBjoWfHp6Yh1f+Ltpk3TwZmfgOPJybFk/JqltmQdZFkQTQ60VUOiVy+hzbrJINdfSAAAgAElEQVRUyoeXmTu3MLaDTUgOnBs0sEWTiH+76O+uGfqsr3XhROH4dNf9xfsrY2PTzgbVF/C19wcTZiHQ5eCMwUMguFwp+64H48FHvrLQAZ6jFAymuaJCZ4IU0LKTw1RoFmhS6LfvdtZ2AhK9PaNOyc5TjfAJ9o/KMdkOlgN43ttju7EFQN/+lk30QhwndTjVYTf2o4PGRg7y0F8yJGsBbQxzszLjlJbjJfGnkxV6Ay/Q3B/YKsb6bK8Cu8XJvBQ0MyHQdeA5dVm/USoFv6g3ablorx0RGmdha63WsXPtKF0nxN6k0ctctuBEHh8YQKyFQoN/7loEnm/fdqp8G7oo72ZOfiHDgU/ZAjr60K9Io1EO0eyzNvPrP8GPr2veRPQD+C6A/sZM23FQ+JrsxnNotiMP/ZY89I/Y4D9Diwygn0Pn7ObV0qQnZj+0UOh/m0C/hnBwb+8Z8CwU+uhp1/7tietYJexbWAAfjSYaEnWBvv5IZCgydHMIUtBD8sz3lwDtXfN0yVsB7nEr/9w152sOjrPwVItHCk3FGAI63G+37AY6JWx46sYk/pvmUikvtXQJnquFbLaUDeR5a8fc+xneK9rUJtvZ4EFpjgYnOC7Pcy6RkHe6MtBBCn0cQp8B6NSBM6jNQFN/5sR8yicVS6HLjHKl0plmfJv8/Mr6+EKgkxQKpqWFRoWe5SwHvDb/x9rZvbSdpXFcba3ZWvXXmkSbl93aRnBwkXqhUsR4l8W9qJXC5sqlDFMChQRGl1b2xrt2YIeREZdh5lIdKGJ324sBu4IoCQsmsC7TXgxDIGQjhWUWbP6DPc/znOec83uLreb3M7GmLxb8+PV7ntesVOgjWd7PCt3/7zfSbsxO4aAZiTTHOaQym+V2Kmz3+PNf3gHKb1CgwT7fevKXtyDOeLWshu9EaY4BiDQpNEk05FMu2cpGSaC7us6u0Jap0IEreNsAtwI8esP4CICGSAcptLGexV4LbSfbBDomK/BAoXGnfb7PsjqTH8BzCXDejtscdDnIQLtzhFW2KV4ss0LH8VQY2vxQnvk8iJV2QHQf6DMqtJ+Drt6+du3m6qP9z34yeHZ56Dk+DB44/cZdk+mJ+nPXJ0jrOjvloU2FFjAD0RroGaMeul8qNFWPisdshqfNDLhLk5TlQIX+/Nl/3hHNW1tYvfGE7TN56OnV8Ci1fQvbQQoNIi2LODijQgFoIdNdMlN4RqCF3roVmgv6uYk2gFPD1JP4G7g+S6g4KDTcvI7Wi2XLcsehAWhBswVEo8utiV8lrVOLkfPE80rVkZYOBX3OhBtll3kuujwHxqBDnmPy/MruoOuwtH6dCjtAoEGhQaKF5ch7OmjxJQ1/t/+ZaaBdHrpjbfngISO85+BZI+2Bc0fH8l2lz+ygU+kjBpoEmoDuB6Bn5sU50anQMAZsKpOBLGEmYwQ6VGWSHnTHHrr/3hfP194Tzw/e6mjd1qJEuiUcXg3f7pVD/HUwGqH+lVZnKdEc4DirfwZIfTPcWKUBDd1GyUaC98HJ4bzyEt8DBLYbZwpXu4CGV5PwJ/Drvwt1eqcuz8x3A87b2/b98htqArRHQ6zvYdDloRtMOvflWly4/vbX36CDhpBd+JHXP1PGlPf+059MxyEk2gX0w68P3A76Lr+bWF4ed5kNur4GWTfUGa+jF6zQZDgE0C/6bxDQqazDcsxKhZ4EfZ6ig+HApKPeDjKHpuXov/X4+T9eAdFvtqB64xbxrK+W6bA0HVBA2oueQzItSzgQ5SgptMoSnhXogKnQXMOvpqNbypYEnEN42wNCn2ngBhcmAZOdcscyew8uY3J0rFy3UJ+Fi+5MItDdVjL529M29JSvr+Blj0HDrFG5rM2t0KXTeAaiKxcwxuEVMOQFFYVqg58aX0KAo2V4umV4bHgs4qnz5aFrt78RfuOpovlAnAj3DlxA7x14RjiAZfGYOFnz+64Tp8n/HaR1jAMnfx29tRX4zy/NC6BBoWdmbC1YAHROLsEimZ6U50JZFG2U2g1gbdLiFh0KQaLX/npy8ubdm38iz2Cft0CgHyxSlCM8jRJNC+wxvcLGA4+Gl0yF7mmCQntEOa4w0Am/DltU6IBMF7Zx2tBtOC4Dz6PW5aSjSTYmy0CUQgvCO/tO0+d14nnFJtCHxvoUl6UolmCKs7/d0Cu+QaG9+lMk0LVKAztUXmcHPeYj0ALom6u24yAyLZB2HCDrxO+c0ug5oJnC0PX6WoOfIen0HCq04aDTKQNoIjp7JIHGNbJTpkKTRM/K+aNGrnByypX5NhS6v//+s1ffflp//+6X+1CM9GdBMcxyJJxfokKvrkbAdECmG+V5SFoO2UrYM9SDCt0TFXfPuS2H5aiqk8M52rgpJWF5Eq0qRmnNIY5rbMeIhuk78PWk+K3kZbtCJ4DoTg10stO62vhHfn4bYP5k5ZPtXVu8wdihohWayf0Xdgx6HwiNVYUQs0Og7TOg8zH+T2/EKo0S8rt9YDjGxsbCw95GvHxz2jDQe0jzwb5LoTv+ACRrluekQE/8rv76deNvd0HwONA8oR20CTQujk3NL0mgHXFo6aERaZ6my7tkad7MoAH0Aki0BvrW/Wcnn/7+2/cC6CfA8xbot7YcQp/FuTCCrTqUMYxKiZYloyzO4Dm6zhOy81VoZTka1OeRH5ECDR3f7YYsq8IOANnCaR1Oy5FIJMhyKIVuDHRhPQY0wxV38qwtxxkuLksKhYr5wq5tAVEpyKpdC8VCDU+sleFpcNBjkT/6iPid7+wBuz0M2u0tO4Beo/TgQzYecxNzcydra6e7+/dpIlkHoYFpmVjJylIODTQE7b7SYwxIoXOz6DlmJ6nvO6OW10/OTLq6ZBcV0ILoL56/Wvvb4+/ZPjPOL8lDTwuRno5ImyENNETvtNuIktnoOXtNvyfcVN+sDn+JgJzx5cQZ2q8A6ESA2dc7VuQqoU5zKBg8OYAOJJBoS3vozs6+Bl+22gbhPCIeVRvmNqA/AumixJmar+IY+CuGQlUbz1wJUorFGg/seAT2eWwsEvGJlJS/3GeB3kOBBnV2e+iOQr1eP6nXf8bnOpiMwocdVY+F05iQAp1iD80KndVRDgaaOmRzXnFoqoX+cYAShlCeNDUzZdRzLAzaFZqKSO+r6DM4bHW9BA8tRDocRqJZoeFJRjh6df0GHAgDTYTasscy+F3Cq35anhjboEG2vQ3JHSGg1UR/uVQIGXd0fWugrU4JtGU18NDVFX1VCqYnwMGKynLgFGgfw1z0elUDjVNmjFRhoRQKxg9VZ0osVGgYfkG/kYz4BWrKjPOODNnJM+HyYUdTrsIx1I3qiF0aVxOKQ+ENVmgIQ2cNyyGvr2weenbW5qBxXKOOc8gB0ZQqtAGNUAPPg4NItIF0i+QZD4aqrKN3SIBMQF+09V21NhPohM6jYAdKQIYwPBW6DccYQBocBuZiswrVJrXr3kJKb3spNPAcIBON5T7d4g/45r3L6wbPpkCX47HYeSyHXPBdUQOljdo8wbNq+TrcFJ+nYdlSZSws9Dk57BfKPnxq2g14w2t5r0lAr1EDFvEMaAPPqfHjFwh0Kiv1WSm0bMGamcmZUY5ZinPMThlT/DlZCJNmFnTm2wE0NFuBPg8OEsoK6L8rhRYSHeUif4FzdKiXBiVd1CV2rV1N9hwB5TMSulXQr/YDs+CtOPARK/55eVY7VyxZ8GaxWCfNUxUqNIU5rOvwQh+EQyre8nYYN3k2QxwluSNZRTk+znLEL+iBHPTYMPsFgqqir4YLXRoQXZUCHfaT8cP9/afi3qH4BiH9X8Fzs4AeF2bj7p+Wqdpuefku8gyHQlZowTSEoZdMoDFqZ1qOHMftZAya4hwZNcOfUuCA9MCAA+hbT168BJ4X5G0qNKRPkejbXEZ6kQ6GrNA94J4vNRdmQpc51hRbCS+k243p0Dx7CXtkebOhWhcut8NByDpvB7qLxv6TMO/i2dLz3FWKy8MgJVWMxPKuyXNRrtX8CH3mziuCGd4rgS1vgAPJqxgHEF1Rn3eDrnU197EK8ixu32IQrdBoofE8iAr9Q1N4PkH/DJ5DvE+BUoPhSI0roOeJZ1DoJxS2Y8+Rs1kOyBNmYH7/VC5HHjrDK+wHeVI08rxl99AA9AN0zwugzgvKQmMcWhGtJBrr+aOXJNDRHiqyC7S2NptofkrYX3RpdLulp4/Cd5ZFEY92Wd3RLrd2wo5DKspzAy0XkVtWCVPffkCXgjBiSfK8sh0ztmFtxthxmIHoou1q4J/VPI44b05WI3TLm7h3aJ0/wp21m0YlNMwuuNrdXcHxCOX1zbEknAgj/gfHE9BnPg/us+FokkK/Tk8Qyzpkhwp9LC1HFnGm1LcEWs6ZydmiHCqxkpnMTYzn4EiYmdRthYNmmMMO9D1wHGQ3BhcWF+2ZQmU6wndULceQsB3wwaVeVGgq4Wg+0AnDSiuevRQa552LN5p43oWG2GgU0Es8uQtGHP+cQCfkAXOXipPE33TFegvV2MjIiKHPZh10VZ4Ig2piEnRul0qHhUN5FQ5r6+sOo6FvQ6ExyBFUXV3lzTguhZMeqIw7sWKbqhaKgO6GPQOVjVqpm/xGMhnxdyW7eBzcMfzzASK91gSefzg2EioTHIMW9/iRBBoNB94OoA2FnkHPIRV6UniRDKZVMgP2U6Fs+96SXd/9NyjKAauDKLyBPC8YQBPRINDhyGiUe1eiQqHhCd0zHQqbDXQbToxJWG2WmUuxPPKFvJBW9ZsnzHA2Lh6S1Uo8GaHNpdBdbNETQcB4E4C+Ys/H5TcrsjlA8ize9D+Sx8WbAueQkSn0OJTlaxteAl00JibhIrhgiO250Oc4LtKKF6SFxiVyDHSedgiARF+92jKMAbtIUhAdGS01UOh9Uugd41AoJLpeODfPh8dpKB1NpdKGRKcwbkcKfSSAJo3OLslaDuY5N3P0zoxD04YVCbW9FWtgwLaocMsetrv3/QsZ47BdC5gpZMshmP5NlPQZmBZEy5KknmjPueo3/C2HxbMJJNRecWg1+CBhG6DQZsnOAKDYPcojEMjbohyo8K0Y/wOgqyOk5AqJ3fWNEe4QIIWGq+ZIqeiQHc20885+lHeLDqLJMmOGkCN2wWCRBRlfCHKUA4LdMUOh86TPYDqutrS0AM9JMNCR0dGw7UfMYdWm0DsC5h0EeZ8Nh3icW6JfjwtphnpTthzjaRnjSGWPFNAQtMvOLy39SK8oy5HLHqtDIXpoDG9MaaJ5vt2kzqygVD8QUu8EetHmnwe5HnpVm+gIHwyh4yoaVSUcPRDhaD7PiYAqn2Or0Zbwn+RhA7oVgYbfvOyekQ4xETvQAZqrhxESzDmTll9p79uo1WCO+AjVONkU2jwRlozd9EUZfyteqPlG/oyJ/jQ4Oq4aCXFyv3iicrv/U3c+IW2naRxXW2dlrDvWRJkmkTRtBYvSTWDMDxF1Txncg2lYGA+zuS0EFhJY5yILEiisB3dgaSgs4zF2YJF2l9503RVLwpTowa7doyVIxEsL1svC3vb59/6Lv7TddmTb9xejLSPOwMfvfJ8/7/Og32DAV81Pwj7Rsu6RMo6jrW0UeQa7kZgCoEM20NXf3HcVeks9Sp/hyXz1Y2Sg57y7L+/efayS0GA2bA+9l8dDHtoCmrMce5mth6LQBUxDc2WFzTO9YvbebzPKHxTaD2gS6XmCmgzI0CT2QxvTAa4jimm7i+CdwxposBw/dsZOpzl6VdFQAkNfD90lVfEeKld+phWaG/XMHkO3nL5ql767ZH8AfgNWI6rlbnXnXJ1btMQTcb4lAr1jeKnt8MR97Tc4y7FTLu/6xlnVxg4rdD/3blgXvYlo/OVocKjJtwuDGugyr8TSg5toQCMINO6dQ5zH6PZYaCoBCm39H+JwJWz9F/9tHeVZSbQ+xa3Mo/fLbxx5s1gefPz37ZcviykpqlCKAyuFRqGz6DuUQs8szKgbK0f7btqOA8OJ3IQl0BMxWYelptwZhb7qAI00U6IDiZ6Ht3+k2kojFtEUGAboTiwCLZaDHcc5KHSXo9DuinvXQ8sX9vVcArrDBbqzQ626APX/qQu0mhUJwk4kRUymT10XAM9Rp6jwJhNtafymrKbvVzhzjoPCvc0Wg6DVXqx+FuhBR6Hho8HdqIOK6LL+SZHgiplfcF8UGnkeA6IpHEwgz4m4lciOhwM/t36h/qMcx6JmGXEGjX6PRMfGiSc3rzwvs72dwZZRdBxJnlugFRrtBrVyCND5lJHoYyfLQXUVIjo2kdMxod+IaD+FBn2eJ6LnxXjMD2FQWDIemgJDykPjfqCAnjZ6HpYDPQddSJG+/ht+dW/fAabtekWLvaqludEpYgeF1k/t6OSuZTMXpFs2LHfXG9XaLg6fIccRPLC7+i2e9dxRdU/Fl5GyHivD745C40cDRziKpcZDFgR4jqygRJuyIa1VMfoM2kw0JxLRuL6ushkP9AV+Yf30RYCZX3Qy+EKctzLvHBdunCa9OQE6dTR3pPN1iDVCnVQKzTno7AJmOfQl2Ukny5FO83BobO+njd85e1VhbsKa2sjjwByFvs1B4TdDQ3bhex57OUojJiZEEz0Qius7KwR0uB2e81Dojtcnn31PDz1OT2lHb68P0De6bkQchbZy2p9e4cmHstxTD66Ra4jlm7c4KLQvAKzyNUKpXu8w1ObmlR/RFWtzodoBbhHdP3hYKbP3QAdNnqNW2wyu8N5vbd8PkWfEuW10lBMcWp+j8XhD6XMg0OfUWRaN4VhEnJFnxPmdid44RXVmnj1pR5rzinMcD/Jq7z2t0FkprDgeuuAUVgqUtCOVTmvPEcupJMeEdWvljEIL0FgkdJJ2f6W03cjAdGg6RHm7EFI9jjk7DTQodLj9s/Py0FZHUu9b8qyaVxXQrVpRv3UUukOntDs7R0l6Ny0Tbd9CPGSJfhCpOkDzNe9BHRE6c6F9iT7ccbYJsevgHDRp/c6goE0xItcgsaKCSQ4z+rRMZuMyrp0Dngc0zqDPQHTpQHjuC4RWNpuBFr+xDE+GH4A68w5EV++dsDh7nqfSz8g0+GnJ2CUhDkzZQJNCL8glWVNXUUDjnyZnPG8GaGbTEcv5hITS19HkoW/fvi5pOwQaqdZct5VKJR0RokLjexyLhNRHyhJ9Pgqtb8Gi66Cv3tjMZC0B4KvgvJnTWtaiLwv09joKzfds8VuwGY/8aTVC4z66FdCXhaFanS1HxIZ01RLmswp94YJfza62Y1W9SaH1nnvpbtLL3frNUtkV1GjDc+WK4rmN/QbFg1E6AHR0oPKTg814X9/w+LdL31mg3llfRJ7xWWZ91k/xfyO6uvHnoyTBTA+/mT47VGaW6GLRzUPnsxbQ6uzpwgpY6CwBDUKdlgq4xIU5+1Ih18CbFBqB5sZSIVrl7azCCjnoUGgc3uJY9Q5cZIUGnt+np/+8j3OBXN7pc8RPoVmiOf1ci4hC82UBnfSqU+m7SaH1tH55mgb3+21Lqck2IeRX7Ab3cajit1Zm9h3BYB39s53hUAKN8gz6PD0m+hyFJw4nPDxcul/CtRPjf/r10pJl+u9sKYUGdVYCDWDTeev6SrV6cnrk4ZlDt8HDODxJPluN0Og6ko8fm6CQ9XlBzeVY4CS0VfoGDw1EFwqgz2kprVAuOqZoJp1eU3Hhmuuhr1OzHQm0bugYUqXvERoBT4aDz3goFA2rtbGo0CiJPR8KwDf8B6if/ecsoCOUPbmhPHRnJy9UObhy6ZLpPt01QHOWo9EEdFPhz+3U8JHo2gXenGyiwSagB3UGL6gjw6DjNxBodM8jo/AyESHwTIZjOD4cBqZxjQryvGRdm3qC4ry4yI5D+Q3kmYx05vTNBZZ798BnMMhFds8efEGeI6lyz1xTSVJVJeV5aDk+Z6AXskT0Pq2qZ6BnFhYKhT0NNLVyEM90JkWk4cRy1sxGmZ+01tRtB0CvSbediQqHWKFJpEMcEQrQiegnF9Uwu/aecHvPh6vQrYC2FPpwykSeXCr/tC5Fum7qa0LXYQS5zh7aWVe/2mL7hBk7WvUBGrjlif1gMS4oogfPAC1Ouh6sr2A8uGrxfBAR/zwNNE9PjXFBJco8o0DT6YsDz+tL6+ZfuPZoS5sN9tCMNH2QkT7xTc1s4Hly+uUXX7Aw4ylmihQMskB7dJcwxTXCFDsOPOAyjiwPvYBIu5Yjn8ybfmgGGt/SCHOamUYjHcvlk+nYhL3IfmhItY+Kh8b9yPuTwrNF9ZAAPT0dMho9jm+JaNjKcnywMOPp7PQFut0AvStXCEwMeWlKAqhN7kAFI20iKlZoQNoaJVp+TT//TostyAfI8CBZDpPgkFZobTr6dbc/yXO93HCqj2UVD45ZGTvgOYEGOoz6jFBfQ54BaKPQTxZxDMc2Iq1hBuuRUZ4jk/n6y5OqNhZwNvAWFkhyBl7FIn2aZX0mkPFLCgk5ywFYp1RNJT9HAg1RIQJ9FYFWnoMsx8/+xUAD3y7QQHQhnU4z04Q0OY5JzytwBTxnepSMQl/F8Ukvfnh+lJe6CvA8P2R56BH20CooHGekgWhp7kcP3dPzYUPtd3SWozZlFWx0AClqtlmnW+RXrJSXUmggWstuRQPdSqfLPjcFMBIclFFJg/1ao4OEsVJq1GYCul7f3WzS+cMgpuxG29A+Yws0pzfIQBPPw3G0HMgzCvS6Jbr3AOiX24BzhvR5OUM0i/Wg42W+BlfxCIwFEa71WD7PZmYz9En+SEQT1fCQUqv6YEp5Dul+PuJ4EPCdYYVmoLNgogsmKASgKXWHpmMyrSx0jtZv5jgdPWSmGcTWbIX+5W//8urV6en+s7VvdAspIz3fViJ95qzdgLbRCSL64idhaeY4l9L3OTuRKw7QTX0hXe1qcFG1Uqnfd8boi0L/0Sb6oIXlMDcKy76WQy1U4UQHibWWZLmFdbC7uopErzZvLjpYvU8Z6FFq4KCO0cQ46TO4jXgUtTk8HAj0XWO/Aec720OrhN3yFmuyAtk5W4+LbEGQ5GIGcS5aYM96os+znmQ4ONfBCelUUrXZIdcC9PfHR/msMtFZC+hfYdquULD7oamfA500SDX55xh+mMrKEHONxW/bQ9PExhfA9P7+fszMMKAiS1uJ14JNo4FGoBOEMz2m9P0xAt1Vfy3QXZdazkqs31TNdoboyhuvEJ7dfXVwgcvdiLN2Fla6A28KiF+uNBpn+px2paKiDAcK9DjXUzBjN4wCjTNzh1GftwnpOw7Qy18BzYgzK3SG39xTJKnmFyKtUEZhJo2elRwHPkmyzxgUpjjXwSQTzXkEev8pdT/nWaCzEhQK0Ii03eAvkzm4J3omW8BYMKcqhWaUv4whXZPZdqTR129DVPj06cMfjo+fHx/v7+Mcf/EclIfWFnpALLQQHQ2rfuiejxBoTUitt6m3mm1096F/7ooLK0j0vyEwHDwE1hq7O2++E1tppdCOJmug6S9bT9Q9EJ4RZ+qwG2DDEef0c5SyG+FAX+BaifR5aRsU+vdulgNxXl4W6yxvDspiPTzFM72TTKOBVnDPafOMAWES3q30Mwk00swemoHO5hcEaQdoVOg9W6FJnykoLMxMkj6TROdkyF2O78pSokOAVmm7z3EBxe8ePgSqX7w6ef782bM1NU4X1ygR0uKhE8h0AnsTE0x0jxpp95EBXTGtzH4KDcd/CMDuLe2hIw8kR9y0Y3PHz0yXa2fTdkQzM6yzd1JkIaYHW9w5qVbq3I50GXkeY8PBQMcJ6GEKCFGfS5ivw+bn9fWtxSe2Qm+vbC87oaCDsycwkzyjUea/NYeR5uqgQtpADS/geQ6ts5ZodT9FWY4ZpdD7+kZhwVHoNI8yoEoh5exiKiEtMJv6ylrTnUK8sYLrNh/+4funECHune49W0OZRqBLlOkIiUKLOvNbtIdmGITVyCRu7+x9++aL/9+xbqxstup18iO6NkoN0ew4gg/w2lXLYaN+o0etDj36Jiu/4R76Han6VjMaq3w/Bfs3OP08JjyLPDPPfTQDeonsM59/OkBzTKjkudlweNZXos3qs7LQKkAUppMAM5W+2VOjQielkSNJRBugF+j5L3XnE9pkmsfxhICWkTpRE7VpS+zwCpWGJT30fRFpeqv0lAaZ9fC24C2wkMC0CxsWQsGDC1lBtgws7HGmXkpnmWPBJVQUdxsPiuNpmSWIHhfEy8De9vn+/jzP86Zp6x5c9U2b1pk5dT5++32+vz8PHQovAugVttC+QhtRrirPGkFzhaUKsfZ5BtAvf+eNYMlMIZba/fjyj/fv3zeO+h2YXkf7KNtoy3NB9Zl+gEafjUzTMB+PYGX/l2aij/h4zcFPD/2PDu7wf7z1K5qS5ZHCX/zFMr3hIYc7F75KDpB8PzmZOACKQOcyOf0n/dx3xs/sDywh6/el3A2/QQ12wFl5htsY/4rcs/KsRLd333pAdzqcaxDJ7KHjuFbrdpNIRyLTotMb0dDnpnwNb/KLkOZ446ZEHL5Cg2YMrBigL0KhW+iIblKtMKHQVRbpeUx9S2lFFPoaW+ntupjqFy9l++gl6eUgoFH7vnXriZHp+8//8Pbd7WcvRKEXcCpkz2FPhYC6UiyOpqkLOQhIoYPssT2en8aT9oB+cHg7amXwHjbiWTr8c/hwluP41Rv+cpvHVFzMJ4qAeduNlM9wjx2Y95R9/+dXW2NyGEylZkoz2jFaqGhiV0ajOsXPwvPeruW53bZ/Ob7tUNdoR8SZk45uLRagN5x9jpxGcwrNXwZYNlaj9tsNrquE1AYd2T5omGgy0gMKvbTaevH8IhTaoM0WemlF71hpyiIDxHZGppeW5l0F3PWP1lWoE0Oyy8vLAvQaM/3j7w3SN77569v/4CZSAppSO89yGKDNz7BgiE5jJwfGn0bSfDXx+zQSfSYKPZIe8RPok/tbdL2snSlMeOijUjsZ8XbjK9DnvPLM5e1cPpfzFZv+1M/nbU/z02x2kAoAACAASURBVKf9Ce3lT1G9G36jMsfzKVLyBs7lafIb5T//5o4KdJc+228tz5TY1YhovOJO3P3bv/dqEOlIkrqagzlyTCvOciwUnMMQ/xzOGZoc8ouQ5hOhWo5LAwr9/KJ66BXy0J7lwJGwWZW9HPTJ5pkFmgIPy/NlB/QlVAqXrUKvcY+SOSEa5/H8iSr0gvYmcQZd4B/f1S8K5kd44gR7aMyYViqCc/D5KPR+6cjWkOxpCjIozBi7grvA73m53c5Ezo6pHLeK0RwCew9ev35kXg96chjkwrYbSMzlbXVQNDv/4DWMx2u5sB76fCEFnnkhY2VO5Rn1FPLPWDBhBFrqKXskzoy0SvTfO66Y0uFvjDYbU01AW/sc1RIgqz47pkFwxM6ZhFkdx2IjNDYjZJ5ZnVcbi2+eKdAN66FZodlDw0Y3PYU2T7W6tMQaLR13lHHwIqW65hyIOQ5MfTPQ63YqHDJtmHYe2rbbWYU2P0A86fETstcOh0IZmPr0VdoB/ahyeEc2T56fqlTMR+UUXf4NB22J9gQ6ea33ULpRF5RII8NVQtu8z/10ea4ScnWQEM+pIZnAPcg8bHWBL5ygBjvFucIVlXF+YKClf2OPBHrPsExv77jXjvI6VWd20LGBOcZ7ZB10JNaZ8K0pzpbvjYfeqTCkIJozjhAsN0I9FIrhoNjOKXSLUg4BWp6Vpt/gjwi6GaKDtEpNStKnxLEduv5t6x0rtM3tfIVeV6rXYT1+sAq9kCwUyqGw8AUpwzivAsOhMDD/43kEMPu5KPTW4UlIwGONlQpNngLne0S0jTl+IYV+Xw/NHc8Zy3TG2Y2ccxy8mEP+TZ95niCghWcD9AwvNEdcV6kIzWixI/uMW6ynqb9uVw6Ee2C5u4vs+Z/fnnz8FgdC8+o4nJnpOBlzKNMeyeqg6ZPyjtC8SKZFrW+S02iE7DS4RsjOw1qOVouK3y3x0E+kUriSTDmq1VbYkg5SRZp0mgrg5rup+kHLcVCh190Oacmh1XNQvx1a7STlyBp1LhS+NO/FUes5srB0pNHB56LQW0ePGRicK3MzqQspy/OVe5ZoTu2OtRwyLzspCp1xbzkrwXmvh2NS/igLDCZ4ccHEIM+0H0nNRsACfY7PhFfvunSDFLrb3uUGu87t20SyQK0JdFzrRDAeLt2IbZHQ2uiBjCMGz9joD6op2zBeejFaDENVaO9U2HB7OcRCq0JT++iKFAt/sr0c1Wr0p+5SnVpIiWRS6Kr0RRtL7c6E9bpWCgcV2uIse6KdQrON9jR6tjw7ezVdhO8oBqN6xXfRAI0beD4jD7115JqbU0L02N3TJdnSqMsaxXQkFLp3GM6ymn/SBXRuB4d9tF/UtfbneQuHxZkNNANdkHSDu0Wt30BVpXwX/XW7expv7KL3uU3NG6LN8ohEx4xyTUxHHDnTocGd56Q15AhJph8+FL8Rkt1YDCOtpijMMNDm9UYmCBtRREHHqhwKCegWl75XXHNSs9mqhU0mmdIOYttOrzSr3uRKfZhCbyvJ66rVa2hOSm0qzwcsB37NzRazXGAh2zEaGKDhO4LRIwar1KCq02bTPZIdSfwh+0FrNA7o74e7DVrZFOB+LMNzaa50d6x0r7TjbdL1THR+qEL3vPtTuI9fq9zWR+dEg/mTs+dczvMh7J7H1G7wdiRyG+ex70usM5UHx4usz5hPucv1bsnriGXhuasg23yD/DNBbT6IXT4YQoCd7yCljgYiO7IbNbyFKAyGCrX457Ch/tm1jyrQLVLoZ6rQLSH6me+hkXI0q/LM67GwXpfVM9KoRCr9r0Rsl/TQ5Db4O1y8uaAKvaCl7wK6YK5S3wAemI/iuK6Vm5nJjgaGgpH0kRMlQTYxXhIMH+4OPpgVdwp9yJkwy5v1+Eg4l5qrXCmVSkA4wfNO7n1z6HzG2eZEOWVSNVrOh9rRT05Deba7N0ietRlJVMVoc3m6OC4KfRY837ljC4TtNn/QDCGndUQ1ZDruxHGHcVadxtnQMAu6WaiZZNfSYbXZfjEOAxYaZItIN6RE2FCagfOiVWiYaD4YyrVureEempuh5Wud2joYapiPKU6kr1kP7SR6+caNZQC9rV5DwV67nNpMKdDcQ2qDO445DM5ZijuK4qODuTk6Sc0Gw5s7spIckAaOZJ1CB8MVOvigCr3/dP/kq+F/77AVBERj5z8dB68YfT6zYzV6h0sr6n2P8tDGPvekZ8NJtIh0RsI7O5cymc/lnbGesDzLKpkU11LOw2/gMBjMsnfGcbBsXufGz04X/uLxDJRVoTudtsozvRPMos6adLB1jkmg49rGBrCOyS2jsd+ybViOSaBDKqqECKKNmWaVhjSD6EZo1RlE69KCRmtJgg4sPLczhYMKDXkWhW6K56h6QHPaIWuUfkoWVm7c8D30+vq11XmZXEkqNKD2LEeZj9Z4AvM1YM+BbbZBMRscsr3RvwPomGnAD+6hH/ezqQepQ3+T8F1vFSwAuwJ9Lkn07LbpSqFQFXrojcc9X6GTte5JboC2qp3ra4ElZ7MNWGfgvMPyLLXuwlxFw2fwXPxK4joj0NMLrpyStNAkzF1NN1ie8RaLdaZXJO8RBXjdWnRd7EYsxW8nzUIzYAbRFEuHN41Wh+I4LMxw0YZxBRolFeLZbR9FnRDnwhWvl4MwJqbnRatFmnWykFc4kuU46KG3p7SyMnX52vw1cR7soW35W230rAWaiQ4kvZMupdF0kA5oAeKw3Rm4OyL4JFKOn6m8fYhCyyUVFdpnJw12Z8xn6Z4VaW6383Looe0cPd3JyBvsbHk7wwGdvciNMO5TVGfdM+uzdNZRsZuWyaA4yDyTQE9PG3EmB332bBnlbul/Zn0Wmtsd/6mR3+jE4jH0O6GZv8RxSNFcTG8abOB1PYpDds5hyAlHI2SOQ3Id8ND0B0+eF52HxjQhMe2AbqGRA7ZDgF5pLrXgoQ3O/1AXrQJNm/zrXGCp88kweSiEh/5hW3fQWCONkmEKRC84iTYvHS3U4TV6sqTR5lw4bljGmGERt0MMEWnasB98IinHd0fndRZoKnaXCOekiZ5QhT7eRYtCZ7wO0cmM/l3wt8nobLf1G7JZFKu+uDh4HmZD4jp20ONaUOFy9+6Ag+62ieeuF20oz7HQrGDDOVNthW0HtnTYA2GsVLNCi+GgLwbdMBJdpsiuod8Iz/h8IyOxssKglVRoTjmeWaDRq8Snwq+r3iNMs0JPSTFcFfqSv9uON+2u+/fN0m47qPPmAms0kg7yHRzbCc5pcR0BkOYtHUVsxB86aIhf5aMjwUevuwDo/hETWnSfluFZmjd2yG+ccUCzZPtnwsne0F67nsdzRgQ6o4Yjw7e/mb8R/Xw+CbT4DQB9gSdTUnL7oC6vMz9z0hEUUvg8eA5xHWje25XQThS6Ywx024mz0ox3IZmYBrN0FozpVMgnwpjccuw0OpR3oRmJ3RvugaaYQ6CmD5FmfF31Niex3/AUGiHHSjMJdJM77lzMIRpd1atXBGeqgW+/HFToW5ftAmkbRV9ewwjWpvPR57lLSYIOIhr1KZJoftDqT4PgfKXsEMvhLSX/2Ap9REkF99vj4grlmZ4xVuidpESzh+75l171Br6qgSaBzvnz3bK4QEsoDmflWVfXoVVUl+VyqXtWfjtSOWUaF5Oduyo8JxK7djvhN9Q6d+JfM9Mi1PrUGGpBW0+E8ilYX2eYRZ3pw4PZug/6FKJXG2+i2hNPoVs0JMubOpxCr/ixHZ0Mm5DorxMSXfUbSUmppxJASw7tL1fSRqXUwubmpldcWViwgyuzOiBRLkt+Z3Ae5/wu+JK3KY0G1OLhPaP+o8jTDq7/v0I/GnIexAiW3Fnh9NnY5p3T/2XufF7byq447iS2CUllxZZs4kjBk1oGm1GDA/YzZqi168Mr2Xj8NvKUWRQMARnalTamkEUWImDwIgJvSzZBf0BWQqti0kVCnX03w2ymi6667j3f7zn33qfIpYHB06efFvHG+fDV937PuecqzjgrKPLQ6qKd1L5/Py7gmPfHaT621g0/vS7M/nLvRnj28qw00z2Lf7Z4Q74RK75ZdHW1pPFGtCBUw9E9DXajqzSrQvNpv0uNzuFMbdaXVBaHrch4pHQcshzkHqwtJdqMBwzH1lZbRdpJdNL6a7wo7HTaNvDcx3ZHuyNAn8RRNPW56dO7Y8s5PiusHFChV2yAtE36/xNWhA0PNBXako71Jb8lk0oh7uOW9PwXKjYgzLjl0N1rgL6tgxVvWKHHBXZybjgG4t2/fV+AXpN8QwW6upjz0Pn2JOc43j++5vjuALTGzeXyP+bL8yPnxo7wHFaDE/WJza919MbmZpiWyz+5AL065+6VBfKcC+zMP3djiTakg482nh2tDl33wDsGG1TqBDyD6y2f2AnCYqAT2cbCHyOFbreR4bX1Siy204hD6isYb66lbxIdFoUq0EztTjzSYZhSYNoDzQ0ry3sHbz5+74ehe7BlUXjeiARaz3gLCr2udSr95qOXrgFVL9G1wudXsVD4pa3H5l/+NnM90LflqE5ZDko9xVaDDrL+TL5UWNaOaMnsZLDXZ5ZjPiY6VLNVqv34r3LInT3PftegRHV+lIx1b1jvM/ZblVYl3vi94vwyTutOJX5WpIOLVok2hRaeU8Ua9EKiEzHSkmgI0ylh1pck3OA1WF4hzSgRyiqRGCvQDuAwHxqGw/2bjge6w2aOkxEPrTh7D83OjthzXNJ25BV6b0+AXlkJO7TMR0/AQE80dIDSQlQB3/YzW5e85VArLT7a3SvFSp5jNnwUc5/d/f8aUcMjhW7zmOQd6HN91uyzSLRv5ajHSTRHQ9/5rwqdU+GRQCNnNTR91mhjwrwze/kR1THgQG2QdkOm1z3P+Q2lWShWjt0b+YDmY8Q8D0yhBd6WIZ1uOZ6BuHMYLXEZKU1zGhPtL++hRY3BMx+/U4HufAg59Hfmoa2w4vQZEn0VAX3oC+BNxtIe57xGH48Fmji/dTfP8xNLORpcFS5Y0qHlQulPoulYqizFV8WxixkHdg6L8M0NtUWArS3Ut0ylw+T9vOW+8Zl54Pm+3O5L+/OOEO17+Rf7BFqa7lhbqZrlkOb9sUibhw6pXH8knAPCRNmuxcCzsxt102adjcRJX0vrlV9jcp1sHlzdvvhDpM6Gs/jnruE8OEVf9LtTXRm2/JJwvzsYBN+xBZTdm1TtdKpGQ0rbCKD1zqeYaS2qkOdErYbMhv6u7X47dNtxUdiJuu3UcUSWQ3lu4myK5okz3JRq0ty0wA7HceYthwC9J0CvPLlSjX5rNjpXKaRCR0hL0EHTURkhesm56OmIaCfXBU7xwByPad0UANnG8a93C5Zam98uhHN8bhboKS4IkT+D55m+vxzDdWU6UujyuDkG73MSTZ6rOUk2jst8lGOgjedZbutuYKPVpu6zki/GdYizRBtOnlcbap9zPGM1CEmW2E5wvnj13P0LR3QmIYe7pZlaaEh02kop0XDSeJOKNifs6UBbnQXQyb5HOglvZM9K7Df47MRZYup2znIwtnNA7+mi8AS1wpzloERjL5Yo9EkcRTO34+yZ4+N8Dr13sCceOh5EE3toKPSEemg/ihTdHNsYQ7w+qtAIPOTIZDu+oqJnDKlsF7AlIEBt514C6MIvptDoQqFCG8+RQCPdcD/N1sOWlbJX6DvjjvZmkZANzgJ0v9z3Ao0mZw3oyq+MZr8tRfd1I9po8OTBHfPOWLdUSmI2Sticcv48ovm1Xw1auVsE2vF80XP/fb3nw+G7bpzUdWGfI8sBSXZ4C9KJ5B6pAO0WfgOxHn4Z+Od9VeiRS/2GXw0y4vihEyqFnXas0G+o0J2juB/66CRczSjnsPp3czfMu3NkX+ZPkjWg3x6/9UkHl4XSO6pEn+ckWgc36lkeFblLzK8rb6xYIM080xDnzlamYURoR2JTjT22fsR0nIncLNBombr/4B71Ga38a0B4Jsqf676uUg8emtPpxnZD03KYZe5X/60sa2nbQNYmJN+JxKEbYjcayJ4RbdT4F5a4jq2iJRiO3zB9HhrSpxY/wz6bgx4+P99+5NTo1cXwFAoNuyEKnaVYEqaZgi0gg+bWfpLuixtJkUEnCZaDNNAOcjHTY2Buj1gOnO0tjyRYjk7bA728p/3Q8BydSKF3vevIAQ2SpTCOBaHuBR+n0Mg/mr+F28gtChuh5W6kuGL9i0ijkSGRaHzoiJaTK2TUP4aj89gseS4Ec01DAkNdLN66e+tXN6vM0UmcNZwuRLeBAwnXNIL2Eq3hBlvvCHTZz+WAiR7f4A+g52mf3W/08345Ns5RX90swo2vdSqStm08W/IbB0vwGxJwaDXl5Yh9jmqDOHpieNHYlq/W3lA8h5DsiM5amdfmVGEWnGVRKPbD+Q2ZrOs029mRNGEPh7wGhtPBfppoL0dCvwGc1W5sJeTZvRrQ5qDVcix7he4c5Tw0ryO23J2MKHTYYBgBHTz0slmO5u43/rAs9zxx3ptt9NwX1URso/1sXT8eEGGHGOmKNUmDaJI8KWC7h1z+rCFeJTuMdrpQdEDfItBFud8M0FMYZjd1u1armdsQoHeeWknFcTuzyJSDwiwfo/3ujJu+tVJ4B7XvMdsII4UWc+F+AwOXdBeKbOXO4dy3MyY0qFvY1F2DtWf8ArRqCg65mSutN3z6/JL7u5HXiX8+DQmHBBzDV42F7cY/L5TnLGt5pinNqWBrGUdrX37Yb+EzoixcbwnPGnS4y6n2/nDYihQaKp20zUg7s9KmPLfbG/EWrA4LKwa0FlZ2O95yeBetAn04KtLxdTmacuwdfH8cTjMM5ZWJHkLoWR90oC/6UUQ0J7ius0dGbp5n+csLspM8vmKSd/nBX/oeK0XMyLOreJMKLS9yTCdZFpzFQLMliT1JM0J0nep8tmbHI9f7i9XQP3rnutjujvJcrfbVRLt3QLhqHsOXUaIjreo2IleTOnTo0mzI0xxmFZQ4e8MXu708S2BnPL8g0m5N2OtdQJ8zKHPW7TpgW9nGt5RogJ0mZFseSWswFK63FGt02dF5YGkoOZ4zJftqrB3DGxHRtB+sFXbw9PdgOSS14yZZxnZHFnR4hT7a9Q76UIkWpkPvHdeGl5RpLX1/FVmO4294QFZ8YJYDuidIn/caUdTBsWCeaHMdWriyVSFth1OSSVHoCvDFWUOxQJe4RiwWposoL970YtAfL6t2Q7qf5f50hxEHDMes9nHQO8ueb0wyqOd6OXLHBOULLAp0XyS6Xw4CLSvA/mLsNHyde40DvnhqN8rcWA7qRlhO+5qTYne+eeP1qeYbXRVoxflFJkC/w5COU4QcWQqFhj53zW7gBUFHktJ5DIYoucgqEfAKt6LN8jZON3BtqOPIeWlhmTi3fxiJ7bBJVkvfNtwuWI4m3IaD+dDd88vCvEJfPrl0Cv0p2iSrQOfmOepbB/R5L27l8Ar9qOF7o58h6liSnlxDmjM7hGvlWN1GqRQrtH+rjhoHHn7BqVoPfhaF5rlAehih7E/BtC+YivqZNtnNUKHr9M9wI2tnfte3AX1N2yiArkKX+1XC3BdJBsx9vMz0teXZz6tb29GiIIciWacoaJ6TPv65KN0Yo8/qNTLc5E7fwY+zTE2H3AbOM9BzfJvq8lAcs/ItYu3whWAD4y1G0QJ2mijXKWAOrsPbjvYGbqwVovT9lSp0WxeF7pNPlkO7ux2NfET3DBN9GJqTLmUlKK8jtRUP9EOrffvYjjG0V+hzJ9E956JnG7FG2yhSXzLEGWLyoJH2Ko3/g0n6Z1kP4phwgXvSrkivxUk7qJdI9P+USoy9PfhCoO/du2de4+lTLAeZ2KmHPlORVsPhLAcrKxJQV8NYjsfXDEyaV6CRZfRB9SKlWR59Uea+H++l056lJrhgA74Atf4pVZ55tBWOavu8OKj1QUU6I8BZrpkjkz6OzKm03LrDgeYcFGMJOFLxG4q21log0ImqdJKko/GGivRGEpsOR3Tied7QM1ZCXeXDFedDY7SdlAtPUjn6zXtoWxoeao8SYSbazDiUbAH6YUz0wccf3z4ZI9ETwLkXF1cYcwSk0RqtQMNJi5lm7/+zpfVnwXVMAmh5S6pFoGOe7XiLYuELLEft51LoB1OINp6K2XA8b6oYC9Kz0OkzVWjQLOrtIF+s6iSw+eva+3X3oPDcV5zFa0hfiCr0TH9WcZajMxnToUnUJnyh0F0LYwqcOmMSY+Miz/NrT3T3NMR1gvQLkeRMsM5oMTL0c7CwoubDq7GzG7TS8BmprhdZLkRrtFfllDkeP2hHi0JdE4JnCnQHt7yHhkJzPrS4D+5aGQw+jaQch6HrTkuFRFoLhirUcfvo8t7yw4OffrxSiNVFr3igodCNnEZrdLfAPeCoGK6vr0Kg1ysVS6LxhL1ZuhasWKoxXZmezkl0pTCtb7Hd5YsUuoZbePmSmSBU6Kkpaa0zbdYODmVXs+c6AzuKdN3Jcx3pR+Q4zEG//0yh0cPR90VBXRHGoQa3v25u6o5Bh7ON+KpJuGHhs24blLNTdBJjAPp1RLM5jlOlGQpNoBk8Z4w3mEFn36YtC/BSMi0gJymtR0rPkaRp5DGChR6tq2zQPhNniTbkDhPd3rAdK522Fgu3cBSnjAKzfrvkg7ccRzDQZBk22rnppuYdMdJw0RHQy29++ri8/Md/XV2toLW0udsMjdFUaCfQ/2Hs7EHjutIwbAnJSPbKOxpFZqNZIxZNIANCyHCjIBZGgRQXV5LJjJqZ3WJZcCWBG2UaszCFNyhZDC6UJb3YEJTUW5lURqiR0bhNt7jZwkq53X7v+/2cc0dyyLkzd36kkObR6/f7Od/Jo8LqMW/ReQdx1hMRtL0DDQfCOYleoM1YyFbw7DK9YGxPzL1rb8C7sUwJ5drkeIb52pX/BoBWx7Fm1ZRVxmVrNiqppa0bCANhNNBOCpxFod/PByfdu85v3OM2Qt8g6OGg+mYa6DvzJ5Gl8w5RBIOCs40PbFo0GNuscHRKYynJ89OQZyA9VJifuG82C20wm23epd/AS9mhVT7skeoyrZ6zDPeh/tkiwdKuPDQsU1RY7GWWQy30AM9CFPpLdm4kheZRnLAcD3zUzPmb48hy9PvdqKxQndVLG894wlBfUeiVV29+erXy2Q+Xo+84zfHBn7at/m1Zjq+Z6RCuIzhcYhF8KR88k45fYnzoqWnchPUGS+BTC3VlGbdZWXLLzLTn8JDE+y2rLNdaj4na+AQaSyWPr1rtXThPV/6LCAjhN5jb4NWyyYytNR04qkzThSjRCBQT0GdXstBn3mZnNULN1sFC0zzfOeFObv7xxMkSVOfoEWXfRsrW6Zgv7OyuRoPqNXgbPons81DuGg4qziVUuqdgB9cIBJmjQ52wY5Ks1kOfG3z9uHTVLj02dKQzuS5DowXtPTyLPCgs/nPxJedDF1ml0Dw089AwHllhBTa6D+vx6WDQRTeH8NwFx93TvDm6qtArKyufvxpdvDleOfhWNBrt/9lp9x98EJajmunIxuum3jtUVtbHWzpINja2NCDKrs4AeoE8z95ULz0VCg0fTZGe0Qr41d78mvyIdx9mkxS6Fjd0gNZ+SaIT+JHhUJ5bQfPamtVX1rzFjgqNOHH12bzGhNo7eu2hQXqUvTeNIrOBP4ETCjSNxolpczTv+3hcb+FvLkeyblmniqLe3dj8usqzEH2U0hvmNogzYdZUh3loa0uCQAvBuwI0KysliC5R/TalNoXWdz3nOCy0QR0P47sg0IWlOoi0Mj2AThvQxHmPebuf3EP3tSW6H0Ab0VDowcYApkOe3dNMnk8f9ff61u1/OnKFFqK//+5i9Pp45TGIfvTHyskVHhRSnudTaeUuR3QY0F4Fp98IgU7XOnrysMt+amqWPNdB9KxJtOX0kkJbqaXxLoUGyjXK9MRVy6GVvlqNDRm/wPPkbzKgvdzdZrKu9ZGlOFqavguq+aDrgKcWiRYuDegzWuixTbJnCvS9mEhgaTvKM2FOFRQbXG6xoKbpSHJTW2QaDrQ8ozaY7DNwVqQh0UMrpah9joDQOOa7crfDDEdPXoB22ZFV9srMZxjTH+uLaHMRvsMluiDFBR6lJzjwac+Q3lOaKdGIDm2C/8DSdv0sKHyAT9kYg6IQmLvdfpe5ji6vbWj0dlfNBvzG4OMB34vzCKB3BOjR9vbo9Y4QPfpuBIwzqN1ymEg/30qeQxU6647evG8CbZuy0LK0zJZpNk7Dd0zNVnw06+BupBcyoCfmborlsIOIrgsHTaFjl8lMDqfifPtXK7TxDIW2jB0RVpzVilgez/pGaaElnKPlCKKvzjdP21TUOJs6n8y71YgCNzuel3Kzobt/+GyYPn/IvsUljwbNPh8dJXlODUnDoDk8h/sNGukOlFk0uuywPtgB5JbQSMpc0mtslL1wHBs9t9B4C3rLuDvPptFyKzZcnXkvbD70gDkPrMILKwOvFFpz0kVZDPa7zN31WSzc31eaVaG7WlvZH3QV6EyhBejXF/v7p6PvV3a+gOnIDxdKWQ7V6Hy3LCd03I39K/cjNLRGJaVadwCwz5QiDZdhTQgOtLZ0LExpgnoq9SvZQURXZFoVWpCe9JmPGZ7TAvM0cebztt6ueOiq5cAfwC1v4QhZBtIeLDrRUGgkpsX2stqihwZlFvps3EIvxnDnE70C5o8iDNyyvVWRd/begZBmNCE1FuqzC+vPLRqMdN2TvJ4iCj18QoHePczk+TD8hrlpCHWJHAeth+B6iOpKSdPRKTOi9Uu2cZRVFx0mujSiPW9HjQbMxZ4RLTRToQNot9Cfxo4VCPSg3+/HFqw+DDQkuouiIZqhRbC7GhhqtuPUSt/00Q70igG9ffFGAsO//3M0+kOcaFhRaCD9vNrsn06pyEos657pMKG2XS28b9JJR1gYQOMzs3ZTC57Xm6OPbs5c66MFZngOeZpUj+nt7UC5Zmj/PvcWQgAAIABJREFUkvmgQsNE2yaVzGVAoG+l1ruKQotnCKAX1Vxcu/dq0QbisiY4jxKK49zyhHPbKtx+sETs+1kOnhu6MaWx9FXCmfL871yfh0OGguT5ye7QOTZ97vBF7p0OP8mlhRN57Rz++ELsdKfjpqOXGQ9bVbvBFzMdUOcwHlpdUapTVMgXA7rQpJ3EhvKN7lih36BIj8JDe1woZkNdNHBmWJj6lE4fWWA4ssIKgR7hRyD64PItNTqdO3sjHAdTHZUu0tQXvWRnJrMQrhwvayWc3Xi6Ni23FyibSKfuDmEaXdMq0w07iOg6hUbvBYeqc9jjRJazULthMLdvT/OTvLzDfkzzV2/h0kJhe82ARn0b79WIONGa7EBUOD+PLAWycL5f5d71SY7FmCCKrur51EnXxi5BG7ORytv3s24YN87WWFcXef5zJs9HXE+SQh+KXA+zmreybNqMaPBwF9aiYxotUgwvXfYoyTTRoct5As8RzqHOrIa+2SuKaOigLivP6Z3w60A7zqLQGKe78uacfR2gul+cZwq9r0hrWMiuDkU66+k41XHouYc+VqBB9M4Xb38efVC1HN+EQrND6XmlqWNLOzq2MuOhhz+qnabZWFd9xoNF8sbClXUz3jVQfFEfoo3/6PafmavM5p30MV01U+lcbSdv11ykw3Xo89a71LkWUSHSHGut5C9aYT3iq2dIUj+bF4WGQL//e/ccZ9bjfza29YoKrQ76zokm6Nh61ALJHyVx1kkbiWioc5NlwcasGDVR5/ry3Wh8luvo6OlREugjuo0fv/mREaHYZxQHRaOTzxCMD18Y2lTnToc4d/DWfcZu7jiS8SjUaiT77DcNBN12RFg4RvQeLQc89GtVaOd5MPiX7SkUmNVCF+d5LweoLgYWFHbRogSFdsdxqqXwiodWhdbU9ejl8Wff/uXn84tRWslybFk2utKmBCO9pc2kVjUMRV6OaNCDQh7KsqlE1/Wana1nXCvqjTmrIMwJ3RNzc42mco1jasHurdpMIlrf2UhTWgsdEIM55RnOYUI0Upye9NNTYordbSp6GxptgryqeWhLR695yds2gq8yKHyfhZLQaKY6Yt4MIV8Mhb7jcWDLUhqtdvuuw5yZjXXVZa2k1HX/2sKH9cjVPY1c3VE1HhziWCD4jV1t3lB4Q6A7BneHBrrDvDO5lpcOokPm7SjWZUa1anOhP9hIGl2UvYfI0hWFWYyiAN9cYToKWueBPXOgBW96aOuHxhd7kOjkodE/Ki560Cvpo7tsuqN/Nid92gXQXU1IZ0Afi4fmmMcL+fLg7dH/fn57eXn5D7l+uLy88dVXmUazpyNnGuq81N7KW5WWAmJf68l0bN7XX2jgH1B5Auh6fSqARtXgJh5ywwYtdN75sAOI9ARcQkWhs14kSvNkbdo4NojbeGnTc9h/wQ0qTdtxNWN/E237Ve3l0PG5zD8TY01+aE6PiWgNCoVn0+j3MqKzUyhCoJmpc5zbapzb2rnfzvvpGjbs2Qcw1mGd4Z8lGBSUP8l4loX0czAt4eBwKMqMG7V56DgfBtI9N9HwHMxwUKNLQF36tVu1G05zyXfI3fG5sfHwxUNyq/k7g3kjosEICt1DZ1kOk+giJvgLyxsFgsJo8H+g+rwPH7LPmiGr4MlJd1FgeeQVFgd6Z2dl5+XF/gB9Ief48vG3l5c//PfxwcHjVwevDg5yyyGe4+vx8gochx4DXjmAZTMRvJn8sx0IJ4tE1wF0LtAN30yLjYfYdtiY01k1jWZM75h0SW1eAbpZm9Yu/RQTmu6aUgvr02amQ9wVZpKPSUki0dYKbQr9LE4KSjWXVa18z6N+rc1GOq78XtiOXKEXtX8DPLciCpTVlEsPsFKrwf2C62mSqJ3Phn/IMMP8r0LzJ2ae7QqYh55/pt1IOPsq2WyXZHpXZRoCDcvR69hSbfarsorg2wneoEIrw6XpMz7uFeY59uyl0FthCv2mYJKjGHxqHtoRF6j3++cRFD7QPLRQ2+2qPsNzaPoObrpLz2HpjgzoneOXF/2Hfxtsw3LIN59/fixLhJvrRkWg2Xg31tThdZa7+YFCS0tZKLipZDPP4Wcr36e9EKI95fGhWg7nmZtqcY44JdrOEW9O0EvXxpeFigB60mU5kh1ocMbndki0e+eZ5Fna7OyvtS0CbGmFJSjWz/ZxFd0c86vIckCirekozTJA/u4seej3Fs1CK9ChzE120elelGYknb3lecEK3XXGzhoMCs6fwDk/NbOhhuMoiGY9xezz4e7YOnxxSOu8q9aZ+ToINS10B+6jMw7yNUhTieWF78Ey2C42wnSA5dKwhkLzq4E9UpZjID9QF+0eus+8nQh0KLQtlFaQj2aNZRspD+pzlws8n15RaAFa/hfyp0GefycW5OXl28uXXK9vfAOec9Ohl083iI3gW0mlN+8btE71/9k6n9e2siuOR8ZjnBolGoea2OrgafUWDgklhieXLiIF3kKdxcMOftLGJsxiwDBQQzeOtGgXWiTFGAJa2Iz3ISUkWRUSKISuBjEbGzmLUuiuZJNC8if0nu/3nHvvk+c++emHZwLDfPTN9/y450b9HibREGlBeJZZvFkv0u4x5x5qpavX636WWMWZ6VU5G8BATpIkch7+qMwoJoTdML2eoULPxN+DJPFfkIQnuLm4UFTaoe0nGTRvyUiwWy2v0E9hHl5Con9FHx2QLhcKNWvnLDTshqkzME64DWWVLRuJjnpexVGD3NKNv8ai1POx/Gix+20IBiX1jJTdUHiOzIZP1Jk6F4SaV8A5KDTeGNlpoLooU02kES2mwT5vpBsmz1RuE+hMFDmNgO5s9KnQrxqq0LvIRPez4KFhOrpCtUNatJpL4sPxJqEWE70nabzx2Dz09pZYjs1N5zeePXIfPDv/cPHff/3vcDLZm2xOJldOj05PTksqjYT06KRddh+XZVr30MaqrfJsd5/Cm716lSXEehQg0kjXrcSCC/v4azUD2Y5nQVLaH2WcWFy4QCNhem02GnUUddA4TCCOMrlphTcGibeAOIkGzk3yjGYM3XCybLajdDaFL6w4nlnlvqU4G89GMy7334YtmQI0W56xK8WnniHOxHmq2H04NKsh2brhtDr3Bl6cjWVZPYsHTaFlpWY81Hyk7tJ3KXHuRDjTQ5s2izrb+iNk2a8HD0pAi25nfeShG5qH3kXa7puzSUmhmYfGE0zHPvwHMxzjsd87u7fpgXaeg5XC948a7s9+9v5iPD5LOzjBc39v0yn0KYk+PXHxoe+9G7Vhp5fa7agDbyU++s3OywpvDGRP9gqNx1wMtF1mp4NCY+xjUlmtJKbM0v+suTumKyo+a6HK3GI4qHo9U0MZUdrwBGg77cXiQmq0bcTyxfAWZDtS6DtsLxWFvoYDUBTpMBRsKssRBBoKjYEEiT/8wEZt+GOsMO0Zhmxx8Te+0E11dgZas3WxfUY8KCHhYe+ygfYizZvR3OuRacPbDHShtwI087VQnRJhMiumgx8I2sxmyBtL2ZlIq3nud96962Sp7fpmClpUWTz01xHQUaUQ+qzyLDhDphEZqlJv8oYoMU7bbW1/ONuffDh/1NhqPDr/8UKwn/waQx/vbd674khWok+O8PAarSnpUbnMoo3/pRWTLS5a2n15E5GWisrVkOdY00oCsnbRZCV3q4vlkG4dJTpReiUzLWxKbq8GrhfidB2IbkGfv0Dh5RcLqtBSAcdADlVxOfSqxm2yC63fYgBpq0WdbvmgULMcL798KjZ6WaAG0t51+FFgVlexnB14TvTgKo7lSdiqkdQT3c6tJ3WvLSJs/v0JKyn3j71CH9M7B5wPUSDsDVWbh5dwFpAHAu7AdNpdzlObRKvP8FYjLfhI8VqKLI7mtGBC2uFZkGfC3Qm5jY0NMxo+4ZGaTous9w1ooTnrp3J7rkDTQYvpiDy0Vgq7ijK7o7v75p890iUPvb19fnF2Jjxvbzec3WBpZpMjxe7tQ6FBNCXaqzS2zhLqUVwNV6yR+2ivlAPF2IzIC0U67J+NiuI6cklKhpLBq9Z5VZJ6PQHQybootB6OKUBri0c5fdGy7F0t9HbMRMmRROY1+SMJLXctSKPEiNean5bkRxNpu+YdbWJ2puPaNWV62ZfBvzKRNp5LQHMEkg8BBejE5jwzEpRpdTfK5lndxnTyGfKMUFCZnpLngZiNgTwbzl6THf5FaSnTaS7AAmlCTZBBdieFVOsDmHtu4yx0J40XiO47fc6mFDplHvrr4KHlClkOtnOAaLxAMpp7wBEPjskz0tHdAPSfXj9/fnHekKnnWx8nZ/0Hv+vbHoF7+wD6xNuOI1wWHY5YO4xapXWQo9x4Aly7jHAMeHNFZZpWes63S8+G+iEqiJrwkEFi9evyMhHXsVpz0aFzHM5Rr8NMSEKPQFeiBAgFGqAipSdA+/1bCU97SfDFSJIayzHyLWgtEP4FVltQE28p0nd0R4sUDB3QX2KaBouG1u7/01d2U6CRtONILx1JAGXGESkJ+/cZDXISP+zG3XYcC9I+vzWB1mgQcaAptCfa3QRlIbrgDQ+5INXunuee5J0YaWm8A8mdCGl9pjrjEzPLhVoPy3EY251OCWeBmR5agZYoEQ8DOuub5/hPyUNL/RCivMumDk3hCdDgWe7IdXjLcfD6h39//rgN8/H3jxdn/Q0pm9uBh5uq0G4teyN95DUahZZRfGqFIM3zDJnQmxJqbfxY1+fmCvubZGrYDVYNZ2/MLs7ekFrLHGrgswr0dQczsnhVUWjnpZ2bpkSvVqDRUaN0FOIF9zxTm4nLizjKtmrHzIntSGpRwq8VJB1SD9PhgG4y66EC7W7Ys42iISNDv8Ew9NpZTOhH1K373iMF29tnGR3DCYx320dmngEznPNjdc9DS24QZhRUhj4aHJo8g2PPs/oOcl0UeXFp7UhOekfVWSimh06JdWrPfOVALjpqQtLIeuDNgwfKtIZ/KRjOBegGFdr5jTTqthM/vQsjHSk0Gv4lzSFeQwQ6GA56ju4YVlrSHB7o7df/+OHTxy3pVGocPPl8IQGizl+SugyAfqi2I8h0QHpkJ1bgtqSTHDWR57G+1JjnYOawGloUqDSqLItRGk9Hhemcx7owLf/ncbSngF0Bzk6t5VRB3f9NeuOSCV0HTpj4Qmon3FfLmDKxU5q9Q0la68FS24ei0i3WC5swHDgmGT0dFOhl39fxS+uO5k0F+uVLmbaBeeW6A8XjvBpVutfsLyfiTIE+vg+3oQL9Zwq0IE2BPizr85BGgwINx6HuWfUZAWGOd9NI93a881B22bWU2nsaDtPlMsrl5Wje2ZlyHKlXaACeAXTrh97dzfoAOnTbiVx3NRnd1diwG6+x3lEKv7DJSVsHT578dbshDvrm9uvPn0XD99/s26m0VxzND71Km0gfxZWWkTZKG9GWmPZPS6UnNDIR+yaIbgakfR9eaRsAtOs6rrlKtVL3U6WdSFflTDnHdCXRibwVrSEmdlC3FlNihbbE83wt8ctSgf59uYyI6FCgblKhn1p/Pmy0NIV6oFFbKSk0LbQ21iWheuKnbGhN8MYa66d3l46+/05xfnxfA8G3Phg8Lqc2wPKwHAyKaYaBxk+vUJjxMJaneJa3nfJnKbqWisKHiR5q5br4GaI3zGLYykSdJQJMszOeeYWQEEFhpkBDnac8NNY3lolmBm9XFXq3W2bbA809WG6d//jh1aPGwd8+nT0fsyZD33FFeH44jXS5eFgi2km1ssxDLHzpZYVKjYMOV5YMZKzbS7dv314C0rMm0ouha6mqPMv0u7oI9RziQwyZrvIouXmk9qi3SmeCg42jCjmyc75L2qC3Na8nIQfAa8p1S4211FsQFQbXYUDLQyyHNfv/xC1ZwUK/JNAYSxBP2NAwUPJ0LhBkJeXu0sn3Zp4fS5OoEk3j7JFmZTBq3hgQ6YG/iPTA4TvIC4M6l6fcQZ2XkHaeOifWuboKiQ6LEBkW6jUU6qwoQ21PGhUGC50Z3aLIE6/QIBweWj75S5alHdQJY6D7TNx1NTREBm/faitjJOzGXQsML+Jj3W4+O//2YvLtK9kp++n55E03DK258lDXaWmdnB7FLR68Iq7dtYQfuWLN9vtrCbJ7KNcj9zHS0prCm10sKXS1qhp9HTTjTICqZDzcb1aRqZaPUfebVxpriddqVF/ma5eK5oQWBRuk75JIskGzKnULNcQWc3dg2nLRQvQ1RobLy2GM0pRCe4Fu6YxnonxXcpT4qfuvMdU5XsfHluHQWPB4yHRd0GfI80DNhlmOAXVaVogIFelcAc5zw9jueRG4LtI8ChjdB3kmv3KoY+URxrFKZ2kcISrNuMxyZIY0FFo0W0wKJDqLFBqJvK6WV7qMCrva1QGWx/TR8lQCeuvVi4vx5t6L9yD6bPIGPdV9sdIG9Kln2qosJyErbX1LgeipFc7ttLixLSy3nS7zGuGnvaSlFlPoRQN6jlGh+g7LTrPHFCeMJ5arVmFmXTyJ4J2BRJcUWoieDwpt/xLyDuY7/J+CTAcKhsZzk22kqBgiKrRGUo+zemgTaMyO4dByzuOxhe0o6OBvA+c/eJjvE2ctDh4fh1gwzm0MTZSDQDMKHIgUD9++7Qm5lGYn1+4xAL64CLcxDZJzB2vuXkgKL3cw47Nc4kJ3PzwUpOVXRLowhc6N9H4WaM70BisdFBp56GzXW44MSejdkocm0V3uxQLSiA+5L4tMj9lO2h2PY6Ab5y/GMinMffjs5sFrRzS22iKo9Ao9LdNHp0exlybSJZm+TPVoFO3iUvke2RskTEZL6qXr0Z4WqYJrx5IYjtAuLfosrxAiKtZVzcGpK26ZDS7TXdLoauSjLy33T6zXLC6UQjhNdPNO5Dm+xPaV5WiDoUfaC/QdFWiNCCO7sbZWZ0tdfb0deWc2iwbDIZ5DdqQcB//c08cAfsOoHgTTIfgOBu9O/9nL4ZxVmgdy7wFlVWW95aWXoNocib5LCfSUx/iZ95lqcz9AneVnqsci0bgEaOmHFp4d3pLrKAHd7as+a5cSWN6PvbOhHY8xkPKgW3t748nng8a2I/r/lJ1PaBvZHce1sBh80NZ/Nk7q6VpZrEOCzTYCudCLYniHioUhLpF98SAWIwjoMuwpnkN0ENRZUuVQU6yt70sglEBhQYGWJqcg0oODnUPYi6CEXBKwfeyt7/f7/n7vvZGV0n0zepqZOIddPv7m+/vz3hwdbaJPzyp0uz0BaCkfcl6aqQ7R7u9/sOx+AMbytb9yAfAVwB0kSeghqXRpPopCH20FDO13XGIpBv6zGGmEBddRDOicKUuKYqyNCSEeF0woqzFupsdHTQs0LNFrWgLnEsvaamG2IOWVWZXoS1+8HL18GYaEfx0LCUPvzEm6uem5+UjU2Qt0yPKB889C8wOJBKXU7UwGabLATOpMx9aDLfqCNmcZJHpLgI4V69ifhC9RbBTuagOiXG2IxbYmmm7jcesRB/5CMWam4TBUjzlrR6c0+HcMJe2SZBD0Q3OIyByTdCMSBMxJovI8dGTvDt0SrI3lV5bnTZLoL83z01dXbj85Pz8a3sLi8UK7zUS3m+0JXrrPMs0FxB6KLvt22u8teZ3u7fdVtfv7S+B4BQCvKNeMtkceKY85ivnntbOUNsOLIjUZLMgq0vRPNxsPMF1Gs0c5SHPUgtWyDuoa7j+uzSUR+gobaEj0V7U19hzWfKxJfYWBho9W0/FSFPrShSphpeT2QLomTRvcFj4XLe4ftlt1J8/rmn3WsXNw0L2XS2647AYJdObEOWPjQSw7otM4wwVrs8xZnBvOfjC/pMwQZbIe8tQCblXWgir2wiByFH2OJbPB8Mai0KLO4phZoZfhoQnnBCtWNigoJP/BOj3WPkpI/2Z7d3NzICBbeHcTZ6KF6SQJm5NesUCz6fhd9d0fl4no42Pe7RFAW5plhp32toPnnsNZDqLYRYp93TIdCb4lxXZpki2Rh1am2XlckzZpLgfLi7SK3nyWmeQSYC5rXPiJJbESOI4auM13nKruzkjsOFGc1aWUkeaQcR1FFslIW42eRREcLUoE9OiL0csR/PPnZDhclbBWQTx4w6XqrnH6/cZi/9DSDJ7r3Pac8xpd+vwHyQ1W5xBmshpZl1neUq7jTGEmbjPY5pDkTDGO/UVVuA1uDX01hGzCNAbDscvdVRneOBRmmpRn8tPu8AotRBvz6NXy8saLgb1JzPbzf1YnAm0tN8eCiTRGexfth29Our1BFnqTmf7y8Z0TGxpa13H66GhoeT4utFrtVltGsz3RTAvSDuygi4mu9uFJlhzbjDUurGgL5EtjiN9cdKtp0XzH7f9TUX4UudAioo23MJfKn4REEti1GafCM052XeY5l34OUxx4XqO2EesVLNEVTzWBTfsQrK5oU8fsrMaFVptHwdorqXrLDjJl3aRuHsm6+bmIExutvXq97jLP1I2Uw/npHgs0gkGCuWs57irOqs2YmOfYH6nlN2WE0/j/GSrVhLSperTlkdJtqhBtotyIKhPJDmkTyDQee4X2HnqZ3lPIhoN6TJPkAtAszMww4Tz0NiNhlR5OAPob8HyHplv0+pWNJ6fnR8fD45MTCzSNtsPaZT3ySB/2AvOB775EjSLbEHEItcaQgX7LseSknaxHaWoqWBHuOzxwSN6uFIFp0usyAR2VQjArk5SXiVauUYSpuKdl6nsikVf8eW2JnWesxDqkaeOuNSa64PqUUP6+dEneghzyXMNLBsvahURuapqWC4p1DmJBzm1YntdDpC3QzLI2imZdBIH2tFwryWwzGGPnMfQiFYlO/yfXpiHoNjzCBLFx/OozeoR79iGs00p11bwNZBr80nkmQJMe77LJeERdRC+OyGscdayFNmYC0GKlmerkaLBJx3CsaDgGNOF8i5Cmtmkqsdw/PX908u7dmQANnfZUT5JqyzRhndPrkHG9hSmheUl5V8qtBYfpFtBX2E1/qi1LHCRG/hTH4XIGcB0W7lIRiEsbPfVrll0uzpEuKTl2ycBb/wK38vGEXwfwXCvzclZPNG+sAaJvzkrnHbLRnyvOXp9570VXGpyfw0Yb1msgEmSe69Iqmrcb3YMdyW2oPJM2u0OHKHUmgZ9HmY5UTsFZHMc42YZOoOwVOXZ3BgpN5BrmuBqYERM7w1EVhN/q7a48yGU5gqBQshyc6cg1+G/KJxkQz8lguP2n51VmeWDhdmo9vKDQFuVNkWgi+vUPG9/9+ezfT05bBcczTY5pjhIP6RiruECsL0Dt4V7qWWZ7zHRPuGZHAo9CPGvChHhf4jxexJuTcpfwNV2oNY+9ZTk6LKNjOuIsh3BbxMfeMc1jMDvdruRL3jPB7H5El0kR1yTSgUTXVtdWVumYZaS1vHKBZ+izRLTYbWNuXrLOLcG5zu6ZYF4PIkEQ3T3o+mCQ7EbX6/KWQ5kDwWxMnlMR51S9M1Gd8hTLTaDanuXGgy15ogfbDXeDJ4af2EGMk4ybwGEYd4HK99tjtRyJnifUbff6iHGmVEdeoQf8GSRyblqg//Fhm2AeMtJDQXo4HOSAfsc83xk6pE9e39748f7fvu6VCq16K8c0fRHUTUSKbD7y9sODffhxsns9AbsvCu7a+Jz7xg9d3udqy9SnAJre/OR5LkcoFsI9W7CpokjscgNTUUSZiI7KPPFFBL2GPWaoATSMBl/hD7TCwm/0Yp7xEp+AaQsr8bx6c3W2UHBEY6P+XwYJjkXmGV6ffiHnotJvKa/h1Lku5pmdxrrPbBx0dxjng672PDttJrMRKHTWuOifUyU75U+aiuUIKU4NADehVBtOVBtotgMbDMvBU0O8iGBdNed8UWV55o+gTVcpv6r+vSo067EF+goBnXRM0sllOQbCsyA9YMIH1UaiCm1pTsRH5y3HDy+G4bB//P27Vz/e/9evalPzhTrFKq3cIJ6fEs6HLNOoIwrVeQ+iXPc+rtihJ+lP9ip9GyFapqdRaNGOYV0lXhROLKdF14dZFg8i0kwfvon8g1K+nFKZyRvukvsZLJIpyfq/Cu0/EPK8tgrTUVgtFC5fRh+pe/Gg6DPvJ1XSbRroP6Jys9dsjVlnNhsSDX7t3MY9h7MXZ/EaAc8NFw2m9pCJKXYnYZsqy3JJVyZlpGMwLeYiTQV8PDCeaCM3nmwW6O0HDcMKrfjGgFjmXYMshyXuPRRa9JhMiAW6A322XEs/tAHIHSXaHpbhwXDofIa9Nr/fRvbOK/QVC/RtFFasPm/eYYl+/M37J39vX/9FcaHOQNdb9LFYM9ntvfZTeI/AUeesNWE90nPMjli6R72fPfr7C1oUR8dwQLRk72QNrTPVvAmtQ9fhDIkWVmfKmuHT+A+iXPEoO/DZd1BdpFabySv02iqgthJ9+bPPFGl+YbdPQOPlSRFvvlpa6B9SWmNP6yiQ5/UgDCR53mF1ht/Im43sojbHgJlO8GyRzBy1sfJMjwAzJk90h3hOhVl7YTr2qWHW+Z5OY+IJBwPe2ALBwah6oq19psszBZqJNl6hhWaaVaE7CjKJM53WcdA1mQ0tfQ+MIanOVQoJ6PcnQ44GxXM8fnj2h/ZKcWqhdfptYb2+XndDDB+PPXYfHCqCZYAtfR9o0WO0m/6LpzejHum45frwZ0ENO011NVVo+w835JnXZVHKTsNDuaCG44jXoBLFkUe6pOEi01yZcbLs9VuRZpTL2HjO+mnroq2TVtvB+4fyvrgEdQGpDkHa81xx7yC1v383FigOhD5IWbDuu0S5F4lgvifOecezbO3GT1vnQSQYII1YELrseM4yYVpHloYjFmL5B0xw4qnXbTrki04vzMDZ67G7TwOkg0GvqgfQYqHVQ6s821OApkuVZkZ7oAmPARuOhCaW6wGlPLxCLwvQOk4ePjw96C1OTS3Wz769e7Wwvs5IC9V75D/2WtDr0IKoQqtg+2q5uGxntkfEda/ZG70hBe+NQPYcp6K/AAAgAElEQVSol1NyeuSTJxiHotPTc3Oot3BgNRU0dmCZaRlX9CWLqeGaZUSTK9wlZzKisRASNqQ0w7sp8qwKrRuIrmn+DkTL4BUt11WfpfuZaWZ1FpDr2N+LtHld0nUcCu50WaEJ5J0LwWCW/ZRleZpjFWaGGJ7jwVYW4pyHueOEGhR3Aqo7YNp0DKtzSuKspANvvQDXsdFLE9J9YZwp0JZlq64dlFqWCWipE5JC3xWgEQl2oNADJhlZ6YGYaKkUDulat9PljTks0BoNnnx/9pdmZXo66p1+d/fq1V8T0IS0cM1IK9Z7rQlQA+zDdvvNiKlGQkS/mpQXaTbfENWB33bOBF6F/6DZG/fhh8578Gqta5G0fGCXoan5QJm9UPMSVBSc9Z2gNMkqKNZcSLDcy+rVculjA2+mshNV/moLIdarKwXO3l2e5XccW6Chz+iALlUW9q1xpv99cvAkOEtuAzptGT4IOc6xfIHmFG4jzpzRELCzTNxy+uxZ+uy/lJ0/aBzZHcfXQSwRwbmLFXzCC9Fy2mKDDZIKF9fIC1toWDDo2CRNhIuNmqjIokZCMDcwAsmBk8GoWK3Xlc42k+ZcKZqEFANXBEEKC68LM41AIINRjOTO6vL7+96b2bXs/N6bN29G4k5/Pvr6+37vzZtBqutycATIMTR3sQrXTLm6D6l1QzMgGTC4qt30V5AFWyLgnAYBjfQGZJsj8tAvoRfzoDDoHwrQRppjGRCyMMe/jxVneagwznjocVDoV6LQ/W6Ubt4rVIrTOxfnoPxTU1MFxZliEy4UafIfnk2C5Lm+58g2jR1FvvE4Yg03szRHr62SOx6FEd86ys20b1+XWUR5zkO38yCx5u0AKmbCpTRh3/euNOsGzLoHF3flWRK73/iM+zcwUXJfYs6PupLtuOHOstzW1Xe/kvlueiwW/4Bm7uz0YBiIyzXwR+eZxwVrnZqbqZM03XcOzqkhOl1L198Tz4bpBEp7zRXmvLNgs7G+7l4GAmuQvawbrLUbMNZ1Wwza2AnMDcI4oOJSrPexLoBCT07On1LWGXAO4KPsoV/WaVRIlYaJz/q58SB5aISZeI4zj2HFmeWj9LB3X+Q53dyaLY5M9N4/X1klngHoBhRuJLzapkeN461tYi8j1Rivc+clk8dGxkHHe6zkPaF7Se031KNezxJ+z6g4CPUdeVa8JMuXqpKXzgaDTCyWZGNPwdtExTlV7Ft6ZrK6npFoYpqePZk1CTxn4lBSHnY4WCGal3jkwTB7tPMiCgSnNWpiODqU12g5OKdQ7BzK+4w0J2uuzyCWE6U5I8hJe/0f35mrEEtgJdkiDSXQHis0V1PIhGjLahwItoHFOqhrkY8CvAt42T+cnJ8XyyEKjTv4I9DknwHggIBu9mMGWfwGzQ7iDSFaXUd8QAXuOpajOf+qH6PZeF/bmq2MjP334vmz8hThDEA3OGpy6uCvwICN4m2g9vJSzfXp0uXxWgeU1oI7M5EZKy4ZFCIbzQfuUqPr8aq6danZlnfM+g9LY0namaFuQizIzISq9QDNQv+XMnc4W5nN+GnkGZvbt2SbUYD59vYWaDP/dMRs1NQ4M8UNFecGTgoar7Eh4kzSTPJMxSoz0JyIRLMsJ8y04JxYgjHTRyDjBVdAOgxCkWnuh0HbAhzgbb0UiAMU7Lrb5MhlmO29wMGZLAcCHdVZkPFTIuCQgV4ISJQt0DHrchzA+UBGgW6hBLMQrQpdRqD3QKH7fRgK7sz+vDhy/e35n6YMzwh0C0DuEM4dQRqPTaporGuW6Kz/+GgMqPhg3HMby7cLOgk1zo2P0ubpAjamxUbHRlmvq7xIs6S5vAl5utqlszQ9INcs1fJOCCY6s/PAhKTwKnaShfYwxxzezVs3b9p3p9wu7GyhNvO/YujUyMDhT05orknWuYGpjXXJ0rWIZsJ5TQTa8c2pOGdCWSUZkU4cki3SiC98ahi2pWQ60gvoTFAHRqel6o0gIKSRb7xSfLEJAqPLg/rMVGOLg0IAmvkO0GC8UKDrYpoF6AfAsRrouD5XJ4E2LHM9+Av6CjjH1kOXUaH3lp+fn3V6N4rFL26+Pf/zlNKMWBdajVYLmWak6dQBve7UnHAze1aw6Vd4GdRDsNYsYB7ue0t2XGnsCkG9ww+5jNDW6ea9Lfh+Hea5WpVBokHasdJ5g6IGA7dGYPAdx5FXe5pWdzPSmvHgh1MA7t+ANCPNi5zJJ5Y9xplsc83gzHOCADIWVubUXa+RrlmBTkie2ykCTFi3Eymsz4k0YSLgJohtKESHDs+Ab0jnNjVwaXQ7FI7bLtrOhaJrUeYLaF8EVq0VZTohrOPzy7sRuQsaAS68OAWn8OolDvrqMX/Oj6vjzeUHESWdOX737VxseFakEWdaU4c4x3GkCj3e3Ft5ftbYqoyMlra9s+Up9s6q0YWWiXVE20Stg2Kd9dbesFh8+zRntQfB5vl0nV537n5KxilxolBfGzU8o1iX9AFUm/gwKq1bYbiFia6IPF918yIC9HSpOpG135l5cFZp7hUEZgdnHVbXxDDzOBDk4QJts8BMTiMVotN03bUaicKMTgNRbieUvcCLxFKNDZCZJCH1EmSZbjC70irKziGEE9bcINgo26Tbw6PudKACzaK/XLCqhNdfvHk2vvLPhz7dBJyDOECgcYNytBuoyUH95Y+rzfvHADRYDfIgnLITB0JIs42G8eACzRy6Cg1IA861X/7si2Jp++254myj0NpoYXWiQYrthkP1ELSfshuh32rGmXif4U0yYC8ORRpPBPU3ZrcaVmqzVdyYWT2NKz0ckS3pw6rTzgSjaLiltqLWRCdrjAOnORaaZTH78iPMXyHMS/oN8reN0iw/pgYnnaE2eMGGJOnIaGzQMBBhXnPLGptlSzTAvCYMs5l2CopywqLMwsxdw61DcMjOg1kO9MQdNiAh84ycY/3/AkmOTRsBvc2T3a7zCXgLTQgDjTf635cnV1Ch49hItO0z0EI0+g0yHA7Qzb+eHRW+vDoyse0pzqsW51UEemMDD2ioGKwdnHNMe5do9qI3PBbNwNJb/KgbX5IFf270TIKw16NFHxP0wtqxks16jI3lnUUmh0dvgZiWIpelrHhzaqRqgGY3jazjWiVcV8pCjcq8s4Vfj3zF+u2xMtdEmRuIc8Nm6UiZjW8mZYZRoB0EpoBwSkRLbQvMotEAOeoxajCf3GLD7X8imGmDuGLuFIrwUppj0WfsIKvBOS5+Pnn30A/9CApG93QeXHU3iuh/B6fu6WoZfAkCHZBIx5kQ08EDQScE6Ob9M69wBdW5dvZvTtSV7YgQyS5sOLFOSK87UOekeohaZ6Xbs6PImvfJ+Jyx5eJSRr05TT1Dm+Xh5o8lfdGfeczaKVV9GsqaacS5+nXp68yt7KYwNncia/loOnH2+p3tHVJmhXnRy/C8KekhAzNKc0edRssm6aissUKjd2ZRhpomFmmKthxkLyzMqswS+8NZ9j8Ha6IstAJuwDa9yFId0SUTHDPChmX6+DGOCU+Oj493fzLxbm8ebu1CPKDmuDleLjf/vtuNmOAgxzOlPNA0HyzM/XFOqMa03eHk/Mr9s6PZYrE4s3VxvryKGGf8Bql1BmgONtTYdBwXMgTsy9BmHfc8rTU9Xcq3N1S11Zow2Li0FQ0ILdiUWURjP8zkoswwVgdS1zyMpKFkyQBvNiQzO7FP8Iq+Iq3+v2FYXnK+MoW5ZnCWrIbgLHPbF5KhE6+RKslUAGJukhzMbWE6VJwTRdme9+kQpPcRYd/HZt+nO9SXE/f9KIx8ATiSM54ipdpADqeIqtKNnyLuJEKqQ6EZjjAOY7gTRadNEOj5vb2Tdx+cODk5eQdxIoE8l8ebhw+ieGgo0tFBfe7buQWzYuPN6fLf/vPr315hnFdWy1M5nNVyPILYSIHj9NHGIxfrAW/dGqQauL6oUX3CjQcFWxdrzwHcOhVHyAc4v8yWKOfkq2lPBHyyFjd+HBRaobTK6b0qP4Vdojl1nKupOqru/i1gTpD+c+Chb9zM67Jo8yKvE7B/wOKaG8RzY92MAjeoGJolEGNCORWGVZWdUIwZXWyzhRt/X9D1zZVB2HdwNpchMs1dpjpivG0JIgHY3BK+sccl5nOMNAfMONhlQBUs88mHwXj3gZHem0egy5OH/WAQZpLmA8Y5FnGmU/fN8fm/fjiavUo4P/8evXO5XB4kWoCGSLmBskF4iwPZyEPdGCLXtcbFE5BraES0G08ueHD0eeEp+QMCvil0m4kdzx1sigURtabMBr2B2X3TZ6lUyr/Z9ppmtfVBL+djoyzQOBo0smxzMxmbYRIbDLMmPclpaEaDeE6JZEedSaGRZHMMZTlMAFg4uBqGw31C12d291GP8UTMCrUaoWnhiMwH+QBkifDIF9kWtplywpjgZo7hwtDN3VjZjjgBGOHCOkyrMdA/ccGGeru7aEWOTxjo8uGbfhQHg0S7lYCO+v1+dH7+aOnWL64Up6+jOhPLQ+QZeV4tMMzpIxN0laYGa6PXLtScChG2sbmgBuoPUKDz5IKugOyGkW90KTLRLtmAxscR/7g/yWs3ZUEwDXKHwdb5xBF6RrVa5fQ175mPYS6wQZyvmR3JSJPvAMk9FuUMybnxrn6tmLan7D3OnODkSUssswoz/ChdcU4Y5vQjKDPO+1isGGsHGd6nwk0GWp/cBsa+A7XvZy98Hq1hE0Y8dqMKnGLrRwblbEcugF86Ar0gru/+4S4M9w5XCehJMB27jx93H/tdH9qu38V4CIefJKfM8/8oO5vQtrIrjntV6KJDoVMa0oEItGopuLOYRWclQxdCUBBon8XDi8GbkI2FQQ3E8JyY5oEYeOmVHw9kp+KhLAoBIafWwoNBxiBjNGg2FQWDwKvHoGQ32vV83fvu+5DTnnO/JDtf8NM//3vulVXCD69i0xHplqg0CjQVn6E/e7JcutWjh49+9guk+ZLVObUXTCt0GM7D8GOI08dw/lGLNXENUHcyWAvYK+k8rTKOBJB2aid6jYs3qzco5Mj6Sa3Qj9ukZ3eahVtMqQAnePMbxlCxv8WPwP0SP+SS3ihgIP45fxioAZu2jX+E+PLhQ9jzgSJb7sIyPlWh2brGJf8D4RmU0eXXJMzOvpyeoCh35jbJJtMwh3ohGBuWbZiHzxlhRtaQ+/9FZOEcJc9gKr3gCfGOiG5pyUoeG6pxje4EgeafbFQeHOPvcBrRgFdZYTrFb5tNS0w03pKe4ZOnGmYak7Pu0+gKXyx77w8q1duvfvurR99uL/+5u2nDXEqRnADth74fckJDtpHx0Ii2a1G9kjEBe+LotnIAXoehBbpXxK+oNmLLKOMX0jCbiyRWgbBWvMdcf76znQzb8jZfZJs/ykt/CoyJh/RpGvjjnfDtu0dHZsNX3d5eV3ismh2A4ZmOVGt8qu0IzYCy40ppzk3jHJ5rbRaEfWxdWnYNx0NxGkNmOC3JMv8vIAdcNmvJpMyaZmU9i8lM00oJy9RaObAjbTr08CzimDHQ9KONPI0zyvgT/A5iFm3JA9oV9prNGO9zALb8JZTl6Mp4jeh0Br9w//3O450v/vDLR3/6++rDyx56DYNykeXAKoe/BJ51fPQ10ox1YkRc3jKm0XbM1nFl++xJzUGkT8CRnFDpLzEn4sGLtpdpqGvWLcBaIdtV2VtmK93yzhsRbcF7Z8e693T0WF99TRz5Abtj/bo42E6jXK1mX2D8N3tNtwYsmPnkxCaZI5wTxYQws+yLJEPrDg3NRo5ZkQ29w6wgjyFpSlpAKRMuaFbYW3pWvE7BLBMvaKUizJaKYGSilVDbikw8j9JBFWb6dPn6nSeW28Sphr5MQPemPVBo0m0EGdUY4Yb1FT0Gmvf23x9sPz764rPfffVqtcS6BuG8mVXnZgro3kYXOF7qJhEukWsQbBTt0MJa0CawoU0M3hPCmhCf6OpIDnRjwE+4n6RL3Sc5rs2cqHYx3CLbuT0lye2BvFuBZt5j8pLvxWU3nwf8UBcbK1WzaeX9X03LMkmzmGa0GGDQkGZ37mZZ7gDMPnsLn2kmczEcynwuDA+BXuotO6ERsEOmWGerpfk1CGcSDaxwrNeBtrUtGbNMU4pYR7yMkG8ScEGcMxfqkoBGpu+O8UWBz8FLQSnPm1FeepdTdhy9p71yrAzQAvUVIQ00R88+7h/gXZ7b26PbCliNXlMXNUq65JxR6J6MG10TPiZR3fWtCBnspeF6wn3Ca7fDqj0Bwiea8QlL+ESTTrqdQJ3HXMeJtc+8T8Ur+dP4BG5LV4viYN3T4NYOqqaoWBQ1LsvJX8MhaXaoLkc0z+ca5g5gTHnOKcqM/HYhDc2paCHKlq0YUx+3soPmNbUIeGGFkpYMQrUK2irIJCOsdNdLRTDjoARs01XE7kTgVpE3NT+PfLCgogZHHD+d3k2ng0FjMG2UeU/Y3O3R+SH6ZI20Eqij53v7+6+3H3/+689v/7HSNJd4M1iyT1EyRPcQ6o1DiC42ZPqQ+hJG7F2j3MtwBMZkhG0EYI86y4405jpRb+1NAOYJMu3w2pmQ8Z6shPmJnLSnj9tzYp5xJ+JE1h3JZ8rdejdZqRrvXUkd8ayrIBaAjJ3/QDQZjpgMly2GS+rMpSFsQrNGWee5P/SF5FwAyoD7GAAeAsYpIeYpaI01rlmCUxQTtm1EtI0Ne7uNg8IJM/ACXvEQePC9Hq29gB+ZaBmqzWOlRLI147wEoJXXk9tDAPSdFQOIOjSMshQ5Ss1yOQYlZmUWnHl8vjd3qjuvNjY+u0Wa2WkkZedC69yT1mw2CehUdIXvpQj3GZG89EdL8SKjkFoHGjE96hQEod0R2da+xC04lpy4+jaJ9uPFV6Wc2prqCLvtWjGFn0C1amU+VpXUn/a6xkenfGKCCy7Zi8no2LI8t0D2YeM37GqS/fMcykDwkIFuIdJjapjjcTBGkiHGwnKa3iRAc4M2NkkegOJAMctt4BhDE03LtoDsMdMej4R0oO6Jlmi31nBaXfaoaocfVEVA4/EgnxAy0pAJ0PjObzDaLMtKEpBW0ZO/Hmwf/ebVDtD8lLeBGul8bcOucvQ2GzCWskAPl1m+u2dA9xl5EtboJQo225ERWmx4BFyDJRHNXnbWhCvjhGTcaLmbWJWkPug6N2uku9iO5OXabC+NBdfFwcqaQoo5JcFOt8JN6FsAeNOFX31zB4+gzHEUazKyzA0x9n3iGTd9PpCMQyrGJoFdfKxhNixDjg2z40KQA4RXECY5Zlh1eGamVHoBUmw9VDLw857ivKb0VJ96MdSCdaRmx1yGRqbv/vUj8fzTT/rQezBAqut1i+fdFwv1LBKSWaHpwd53R0fbqw/aaRiB3ixW6F6TxRkG+s6Nw/5h67Df71PHFQwSwnZ3CYK9PDwjqpfkr9GMjMhvA9lsR0JomBijDiW67pGx3dmYFD0z6RTpuGh4mvD7jXbF3lG+MZdPrC9Qr1Rq1td4v1d5zVaZ/1+w7ouTxdCS7LK5EIMRWjAzyogx+mWqYYAqYyWDBwMz00z08oBjwBl8imSiWYtxNjzW5GQNouxpeKWbFUDMSAek0GbgVF4q18dlHavLeFhYfvnnH+UGB+FMjoN5toB++Z+XCzTfV0qoVjz85bvVh8tdC2Yj0HmcwWIkroN/240+BZBMMFO+a71rHVpgGyfCSWTjDrLrnzHSrNxSJxEVR+H2yZ1gIti6kV+BFwAsAfktSIqQe9gJc348Dzg7E/eeO9wF3sQqntQq67/ZSWEs3mLf/OFz9ssdKl+IMofIcUgkk8NAXe4yvzR1C4RZgkkOsPMQjO+BWBsKjjzJObA9RppZNlBbiY0mUGZeoHLz0vMsvc7CnY24Xm7Uy/QmqYGIsljoOvvnetniufSgsduI1UwxxhHzDDGbffihKZZZmhbo+4OdeUOA1tEyK8K79Y4Zz6CNVLMdQbbPfPTbflfopkpJ12dTMpJCCQm4LHhAEx5uEb/hFir7VmgFP58mvFNMeEFZMCmYMOdOviyo2aWeRxhrFyjIjiF5nmRiMMJk70fbP4JZo1wQxLFmeSgwwxQQzgzyeA3Ogm8yrsXZu2i3L2Cgue1Jg2cAUh6FY5mvE5JNmKXyErA9lU8TLxr1OCYNxusc8aCMn8V9h745TzN5k1I5FohFnz0YZ7PLXmkzo83rcNZ7wc0mHdbgp8vublz3s6H6hQGiPcxuIM+QbiT77PCMzXaX9BsHkO8zqgCOfO24eYED2pRwC9jeQsz1KaWPVP8+zEZHM97phNmNpwW44xZcpnKk7H1PuNbsSrNfMSzIeA0ArwNokjnO2WfgP4tMMrnlriAN/yDm+BAS2nh8yNo8NMpMKNuZd8ga5fvl+AKSZgii+QKXyC8+YKQz2T5uH5sHHvUM1TbLejKQZ6GO640XiwFJ9INBvNgtN3Z344UQPY3jaT0N9INSPYZf+pZpxnhL7Yde4pr1TjBPdE8Kz0ad8Yfe7TYaoNDX19K8/jXl99BzVI/NhIJtafa7lHYL07yNFLJRwzHRpIzQq6BZSXzK1wT7Vrrybc7iC9juGP3WYN+42CFupN0w3TdawYVz3GlKuVC/S6c45jLMXX0BQNQ4RTJo8r8h9Y5PS7MW6G7our7R5TGz3B+bSCAukuWkWnE/xBeSbb5P712YBTZZwur4woIZQcYb9zhx4oKe/kQYwU4kmwdvMajHBDReT7pbTOuDxQJ+40UMlmN67C3inmWgm2UshsTHwvEVDG9ZqGdxr2QkOlN7zsTfLLOBbwan2Li+Jpyxf+8R2lYG0PrXGaiNJeFJAO/n1RuFm9CmGvdZIt7fUFJ8YwaIr8mYp451NOLr+BZfkjElHYHZvRHSXcF9bXDhRRctSJOh0R8w/y9rZ8/axpbGcXf6CioNAyq28We4cFWlGTKVq5syYDfTSIXLsEjiIjBYgQRjkAovUgqBYEYwThGQ7QiDCBKkcXCTMbrNYBJSma32eTsvozkje3fvc15nNLYszW/+es5zzsgbT4p/xhTq6RnG4KCC0tfOMjsbfcQYDpyCKHOeXrtQdrrLWpGPHAzbNOeSgVmzPJhpqhFd3KETIgw1FbqdRCW6tYRxfwrtd5pxLEsviHiJv89BjXWYwSNpFKWA+9L3PBW0qzYbYUDzL13kmGkWnSagdZxu16xGKlPoHM4CdMGQ8FOl3Aj0YrRwuSEdqy0iXQxvy7CS/PDO637ntaKZ9Zy3Xiu6+/0Xag7TxvyMc5Huw7Mz5XQrATegamCx+0W2v+gLQf0cV6pssCyROJZjqm6nFIy7JYT3ieV9VuSOxrdzbdso52OMiqp85IB5pgkWn4KKaPHxrNQsfBFn4rfLBAvICmfT0WAz293udrSVG9LNAlrH4WEQGpBep+naB2WG/VGKDom/XC5lnnDXC1v49QZRhur+odtlnWafg4DeE5Heos+MNOPsE8sepBKgGelj7Y5gdapku9QU3qOTUcEz2U56X7LDXuiJeTU7r61G5ayWp+5Q3PBDzO8P32PRBt0vyOy3w2+I/zdGWKFsVmXl7RMFMPpvOU9NmhLPtwIylv1rFGVwma+dNqU4Rv1UxoDHiHH9+PQIOCZhrm+4GEZ/xb2YWYpcpHmgG42xIXpg73Nad2ND2O4OcsltA75fxRegQzguRa8jCzx/mWbogXS1+IL7DPzjDHkXcO5+UDijQn89t2F2zqWc25GNXU/kGb+WtBzoIt4LEWvlljjR7lhYy5bCmgA/6WAGeyk4v+zzVv+lzTfvJcSn/Y4L9RdatPtEdo2ckppaT/UPN+Rg/3y/8QAVl/EaFnAu+ref+tMae8nw9/QpkHHLwsw0X++rsZ4T5LquUJ6PkOIjyaDEp/nghRbn2SbRs5wwb9fknHcxsJyLEpZdZHcN2Bbff0F55QAb5ZcWQ1NsI0Nt9tWMdxT4Hqi1BrpazcC3BhXH3/LBhEpQor82czRvidYpB8bjGAovI9lZDCTNFpBVd/YE3izWi6PF6Gi0OILKmPT/KKDOSFNSnNcx1zsHHck527/udK4POtfY2qHw/T5mS76VbtdUIRJJu7HUVAhFabmW9MOc43LLR9VwpAfw9s/IpTiDAu1bcZDFSb6dKg+DaL4GZYYGKiwHmzSDGtdJi0+5hRqDF/VjmRnBHjT/tl0MNd5TED9FMiD8CHnAhSqlzJZC5zaLlppWcndgUw1jOyXUrzYlO2OgfZ9WRLfhAXQzwI8OsyxbRiDTNtBBli6jBwSaoiRmCqeLQO9tczf+tNSZ7xWoyiJr7OzM3iC8UJDkhepSUwT72FLsI8ba6DWL9gKR1oD/UZBvruqjg1GdOoQ5dOuA7QEVAJggvqZtohm3+/B53tmfKh9FQ20vF9RsK/mukdON8l0j15sph6bWr2Gs5dY4MIjuJ4T3LYVhaKofue6zq3xLiV3l/SnP8ymEjSwfFDrXCHOdaUbvAuBFUa6fsqPMaaZkeXY0w3wM7zy0x4/bMH7U1Qxv2YM8MA1UqWw+MpOPNrn0YPqY5mAmhtPU4Ez70sEgVwZmFGl4BtJDjEDjlCAGOvywnYURuhxpltHNhFEAWDeVpnroJ4hCv/tA0bt3KNXdd6+ypkVzuULztGR1lwPfsiwKejtpWoEnjDHHtNov5l4s3as0TdJ4EA8STAnXkBec3uhazDyAaU5l/vtqsYKMaTWCPIcKOiOu7A7YasQ9zrxvNLHcc7gQTrCaOJz0vq545ofWD3YsF12NTd2ejDpEDpB1Wh3we0wMftTRnzQwxOvkgz/K8Po+wk8wvujx8qdi7M1CJAPS7yAr0LyhAvnNgDrw+K9ZYtLg1+BqdgV1+mtQIfhSs0ozZw/O7QfXgevNXZlKWZrlNz5z4U4KzkcKik3If+6mdIusx1MoHs2o+MESZDhaRstlmi7BydVxOy+gRXnrh7T716Yv/rW5a9+YUkYzXhT4eRDgE+K/xuKV2N5OI6K0bkAOG2QN2xYAAB5pSURBVI0G9cJGuA5DLDrnrC01pE1rUcYKUtFiLjFX2MbxWCXMBRtK1mnTeir1qPx9ttK94bxHTzScqyedU8I24Q1sk+E4GUOWFGMd6z680jiJbWtxwrckbVVa7bTVrqTy7jkta+M7Tpnf+zVnOlcmkcFJhDMahXg+l2EjgrIM6UTj+RZbNviks0W4g1Koi0qZrjOutX2lOvTpn0Xw93UBqgB01AhDdKMjnFgJl4EVh/ZohvyhEspv+JpxhkTfXL7NdyaXhQeC8DeH8NKo7/P/fNtpBJTwxUT2S8V9mxZyKlq7hG7FeIvgzgM+Ns1YpTGW1vhZ5oTbbQp57qL97NG27DRHDXVx2Xiorip+euqUWcvqyaujRr1w2+Typ4ZbC+lQJZc1ijsE1K22NMfYhwbuI4s/tSzsw28BA5B4YsV/SBjlAIaIAJwXQFeAruqx3Ppfd8xixDpO/fNzo9AuqvVCEPpBDHaHIT4RPTMB/ZSFTo7L+W2JOn926LM+seNncTvUxDyb3eHwp5Hpnxvt5tZPhywPc52hcL/d5OIaG9y3Qd5SkAvgbtN8u6yEbifwTHjY+C/tTylU/1l+jLkYMLAc+Z5H30m+1kuSInyIVnMYdabWj9oNDiAH/vk5HnEenAPR9vRgnmg1g1jFC08RTTQH5UDzG+DU4XIvg3SlVUawwfhpmAWNsZbEZ8vvhr/xs8yRmEDC/D/a8JmQ53AvB1y9HSVst7WCl7ohyHeoKH826GFoKZX57G1QX7ImwX5Yb21cJWEjqHqNyKcYGsWikW7EGT0AjH14EotgoKu7RHxQNGtGZbcgzbwMSQON/7SQHGlc6EcThjtKfIsMG18tLBHilstNHktmbNFFflqLnyDWQvaZXvJEJcxsP6B8x/oHV7Tjh3q0ZzXyk6qr90jSefIc9m0nBt1scbChnzDpMVdxbJOt8B7n3LOiepdDntfztvIKi75LW+mUXBBta1MPliB/zpNQvEKiahASwxSLRlfe9yIkC81np5eja4gkTn0jig3xHsQawZ4sS3KNA33mnZwbAVqYDtS3++8UXlo5w+0KZhi4uKVY+YfGZ6SBHg/2YjVcovOIwycXuzC66uEIq+eQXEVq79KW2YnZbzAsse9UX2C+mHB9caGY/k771BHPtvuJRb5hfgV/5Yr+1FVvjmXVW8FL69HLw5oaNhpYIt834zszooRx5Lg4SrZh327tljU+L4pRubdovEbtPepRUP4Z5JISxyiNfAEaIxwRjFUJaGIfnA6PVLWqwxzkotBOGcUp/z3Ydd6dQhSTY0M/bluVPQ4AfQ+BxnEyfmZVQsxhxaCMXWJYpVYLCuJMSFckbEGRCoxVUFEBixt1XoZJQudreDeEPOcKkmQ5zXTaEdU5YlCSJpNLg9F9GWOIKZULaidcCb9s3yGpx+9pczJRPyOHTeyfUr8Dn/Ue2vvJyQQz2aX95CtIl1AwX8IGcD0BmHsrqqBZrXjD8E1Mz++guRsmd0A3bCfJnN64nMWU+D2+geZmI2SiAyfqvEj+G0x/7BrV0rt5j7rJCiF+WPvRA06Ae3K7bOTj6s4gaJobsIBy8EEC22cRc0c58BrAK4OnBJvNYC/Yo2+MxgUc1UCGmlVvpwIIV9oPcB1VWIMtgCvEM1yBxDJmjW98ZdUoLgnqy02S3AyhSu4gj+8SYBcLMnxnEJ4TxsxwT53o3uoSm8seogAit+pNcpp7X+iovjDHON4bLg29hK+BGOyjbH/kHu35bo5UlXW49dsuXl/kDElXmMvVBOmElf6EKOeaGyA8b/iy4Wqer+YFM28acp5gwsw2hnzDmMdSW3YVu0xHCvE86sqOqlqbY+vjVgdXE/7YSOJYu0uQ2z77zehurNuRvzZfoPtA+8EraJq4XbPRbjdglOhT4C3v2zfdtw9aAo1I7yHYeyTL2OrbB3a0Ele0HDPKLU5XrbhivT03mEUrxOaS58lcSe9QCw9/sKIczQVbRHVlpTylFxPtDtyTet5DJ8+PcCfYfVQQftS7BNSP/7f99h/Gzt61cWYL4+n8L6RcCKjQn6BOYMOCmyBXrgy3EthNmmzh0lzirZUU2y3ZXJHbLBicwlJpgzECg0tDmmzYMryQt7lwYbnnOefMh2R7954ZzYxkx+uP3zzzzEj2mjIqYy4pRdiPuVmmlEqp05T30pRopx2u0Ci4QZFQlRVZhqKQnM0k5dlsl1GSTMVuls+43uXK/NoUTH2DfIi7QX3C2Uo6f0pP3r58dKsFL4m7OzzpnZ8Wfwh8yBrms+fW9FK/eUWTsx5+4/zt/f0/7+9v72/6Rdm5+SVdVejplH/5/Ko2tUU6tQ4tl2x8UBPufVVAtnM9fDZtwUZYhNFnWz8Oeza91M1vX14tdoywfAwmhF8ZjEWsOFPSjxfV0Si1NIw2US2OgCgs3qFxhxzHd2WMlh6+wwGkuLxzSe6MIsafUJT4S5MlItsA1TFvMQoKLiJwT0F8R5RMFKnXTFIiPCmyhBPyicizwW6QY8tyxIAgzxn0fNfetelNpg2FTZN1LS0oU0LTxULLhRT8QS74/i4mpuv4nWrHp3jpeTVGmcUcZ+4gx1jPYKDH7yZW8s1v/0cMLnFFNL76jVED+CGDwalT6INJ4bk5261Nc+xcThzy4bPWD6SnHy2c5aZHb+lwtZL+ulmsFg2U8fInRxl2r3p9QDJJD9Ob8ea0Smj+TA3aPqfI+Og5iwR+FZSBrTTvZONcSv6qNTWirwQpS+od1BPIMuCalFLTPIhSNxN3rhkaoAlpBRhti/Hvw0PbRMIpTag0IYQfRfzf2SDLiGuDtou2rQlx4rxNWIN2IVwwJX5RC+U4rFV7MuHcbnMHocQdBnvcf5AHHBkyPxPug9qmPcRuyidSFFyyH6TQ75N3euTxeNyerFotuFqeAF7oJfm4mOlKlZ7HD7ZE0+sPH47+NBL6CVMrZ7x7Pexd8hXYF7KGAgN/eQaCV1wQvytvCFpgjzLjvJGOLN17PZH+Lm8VvTW5wdkPGS0JX2YZMGPHH3I/05ZQ+ZlKHpLNgI2N0EyLr8g8oPM+pYhxjgse7ClSkB6VjHJZii0g0bxjc8DNyGylwlrGjuvfh3+HMAwZ6UiQFpRLT5X/QLNuXQE57VOryzz3kxrRLrKskw06TDHVDJQlawDKQFuej23RpJwShVZtU9nIvRtrfzkY00OP84H79zLLMT9Vegldecb0/PkVdZPW3P5cEhzHz7fV5mmzXr7azpvQhzy7vX0kZPV/7cZvGRAnMt7sAPbqhwO6+UsyFz1zDgX19fzaLvTV6jPjqFbSTVY1KV4Yji3KGJietdO3QbL26Dw3BVUYkZhiELxTq+hUGSklghXjRA1nkRr7mZayT0jDoBYR8VuU6lJLGcx5OOfBPRa0Sva3kZAcS5YWClHSGPcQViPPOzhmwxNo0w1hHKAKOIkyR/yQR4gO6FiAyouuSYi0qwGw+2nfRYKc9IdJwnk4HCY2dTKkrAPAgDhFx2JHGGoQ6EK5YV1ye8wNrphkcwPfiTrHeDAeu0exnafToWdBz6UPgPvSFTVz4HUGwXpOujxtsWHG+cGfrfWOlCcOR6OQNyrjspitF4+P36fX8rNgFzcFD0o05KzXGxJWsgbXxwX6E4GMqzbm3snBnqxiy6ofTuHQDWcyiTDsGlM1qTmuiafMatRqXXoJkulJoSuzvxJbYc0Fd28mOKOeyrA2KbYBUMvUzwRuTBinbE6llHFeFFIsgHUSf9DdUNg8IJlp9iP26AbGUcCHg5B5Dfgf1U4SCb5MchxEJtF9OQeyK0hH7vZuYPBuRp9St6+cd33W+30mfDjsDx3tXHX8GHR80g+CsSUroLudwaDjJ46kM6SUUGvIfYw47h880QA8B/SehKP1BdmIq0n7nYDGKt3FVTvB+xPaNzcqSIWf17tXGiRnT9Of84sPF08Q9yTLyedvNsAuf7z6dPy3vphlw/P8yBnG+XR6DaAnqwk2o8OymRmFDfisZ+rVz3l7ScpMhYKMLVvS3CXHBEZwFphFlJ0sE8qYCiWG5sZEidQYAMNrsi4bsAFxyRSXKWZfqVIsZtalP0SoEhzGFt5YkT3Cc2yolx2IMlEdMpcBHSbAAav5xyPcaIjH3biyOZBgJdPYBy66AZPR3SMjWa67ClH/IEjDATeVSjhreEcrJKg58ESNbBHvqLYzrRLErgY/IB7bdCB0KYGXnqVEOCLJNU8+DLA3Ctf4SuF8kb+//Xxr/ZxPV5t2nqUygtG7uA1D4nm9nJXYqbZhSq77vPedht4MbuOZSCdEsmI2PQ70vy4tzFjxlrOEdaD5KqUzdRUsyxvOi4kVaJlKEMlrTDBknKLJAuO8bPsCvWSNFpxnKtCiziAahhAanQjWhUzxgXNUODGOjD7TQXYVEQtzXZQdyyftLtMbShEbNhVkPe6o9QA/lGnvUGAPRYopIAfnfCAA9CGLlUhzDLD5eCB4awLUxG+07wZ7kbiAWQ48lPd9n+EuctdTaqZ5WMNZg2WVdJUb4JRp5k0Ko8Gsyh3cd+jFQbfRpxPs96YPjuqxlSIme3xzdTW9gYFtvW3e//7131+jNCte8Lm8pHjPdQGqqqrtP7dUbKkL9L6/vC7X6+fla8GfJ33Qxc2no5eO8n8YN+9dz130DnX6sndG/G5OuAvMgNc6c8AkgxFuN+YeWa7Tliwf5Hbmaxwz0ctmgy0zVZgP1T1GxFukhjhlQ1oqwJFtH/rd+JBHG5XmylTIp8O//QscHxUj/w4jSRxyS6CbQKziq/iyDodu19NlZlnyPmCiD9zGXhyHENz1oBaQE8E4ETU1wWwOQTCsguyqAA8F407Dkmgx5D8UrPv9BtHyVPpqLpo8E8vbLWWKUYTlvefd7JZ43mzISP+9/zUa0VD7QgjHOLeAUwSC9JfqS1WVLy8zLF3f4AQweZCKRHuLtz4sbz6d+AYhFHlur7BrGA9L91kdZCzfKMC5zILJXziMl02WmWepalHoag7Pg7mQxVdl+SUFwkDZrQCoFOtimFrkI3aiLqT+btXYP4rukWZYx/d3h1lkR+wnYDMoxDQrtJExzDWEZawWWxEcMczimbuO3JrFGFrOhuqdkTtDbQxZh4XbxFmIztCxK4rcwNrbMWZDvYb8c3tKKLt7CVFo062J5HA74sRRhSXLcLF8fn5eyc80tvLilYAOq+plNnspCzlhdisXit0+Es8fAPTypdreb/VhRtvq+wmgP/H6dk+v0LvuHbPRWLZr4gyiJ7res84n+XPbAt3mdUnWYWZY1FmSW9eBw5BzBewxzFqrrPZY3xyJW46Yaf+AF9ahHuiysbh2fhd61sJM/MKTMt6gXrn9cqIXEL4R/4X6Yk7w0oEHsXJMUyQ4Ypn0RUpyVFvX0FlfXXv9Zsru1dNftROu2THJkZs5nptyTDGmhMlgrTFG6VtoMTJNoRZrLzzvR6LR25BhHlVbI9L323uOkDzEBiaanG5RxWXFJgPKzLL8MtOr0m4fr3mV4/Z2Wd7LH0OkyYucAvrcj8tzYhcXQKOlSeJsM9k4z9H2qDZiLbltVHogTFt9ZnEeZHImIDHnA5JXyoQzLz+qUDPShehzw3H4uQZ1qfa5fobuyHyvhvfv1jicuT4l5d6sUUkV74t1Dt5jmywYx2au1xUlNgqs3tjqsTKdKsppw6tivE/6PKFLILz9YYPnxJu3ddRS1IT4/42xh7jHc8epcwNnSDXPV1WijdtglTYCDYlVpAnJ1zf+RuFV/PDwjYIOEszVP6q/SKnNZeS3t6LQpN2V/B3frTqt0JZo+6XYZvA5cCj0pqHSE0aai1wW3duH6/bZMuclT6j0wPfOjDNW4BOuX6WmeAXaKdY40vQ1fa2fNBOpZoJrKt04ELvNV24/ohMM23mhN0msKXdcy7HM6sByrMtx3Da6bIxG5C1URIxupDVyUPMYfesw3OxuyPwyRljqtetxbs3BE+iOqPNQHLNnMBrCPDgm0w7psass4Z5Is9XQknHu7/eYGTYttDMcQNrGt23OCr26f3j4+O3h48O3j/9r7PxZ20i3MO5uv8ANpLwQmELN9qrWIIHJdJNqGg0EF0KaJo23mFJF7NrXxQYCwhLiulm44C2yW+42RsL9gEGEsHVgP0C47znPOec972iUZGYkjf4FR/r50XOec0bmdXX7evtyu/38OWD9n49/fLh/8e9X//1je+uXx9ujQNOR4i+ef3M5OSgJpRFq/SQJ4Ucu1kh8dKWuo4rmYxyU+hMUuwDNUzTD4KcLFIcOZ/XSEdt0OY3n/ozoJTN7anQPNWyWJHkQ99Hr6wk03h+I9PvUNEsNCK9h2UVmeVx0y+kV4Tnrcc7UUmGhnpbopEzVLyOOKxBlSMjs/MZ47sg+otHwy02/9YgXyeJ4dhrdMtFhRdJRt92QIzoOQZoEefh0eX/x5s/V+nwdlrMg1OFidbbGtdXZy23w04vLV89ffT5bYTGkj1sOxpmRdqGG7xXy9yd0PTT0eaQ9040QLTI9SlD2HjoVacwk2DKNa1eWQfN02PXOpsKW01kcp2b5mGc4/Vo1iOijW+1RtmEpBi7sUhKOAQWuqAcZ2Nrtd5luk7gZkZxkcmUMMTSLm5pcB21m0S7ScLlwBkQFeZ7Uf/MjxuJrEt3Dsy8KBWZaSuAcVs9x7fyG0nwLOD/+QCP+TytWaCBNNJ8D8PP12ctfPv7++4f75xefCXR6ikH9+tsemr7S4wIHYcnsKQ6K4b/ucgJFXiySFr9Kcxdic9FcF6Zeg6cPCs/xp8KGcHjJ9SIfeq88Heaa23moB0MdnAj7DPHw69yedu+ot47lmrL9wxiDyN1KVNdXDtZYB1QNSVFY8818uy218itWM+NP6DziHM1GksgVWAvuLM+RLTPS80L0OTa/u8sYQV16W9eIHDXRB0CnVJcScjDO4JmCDpFoZjkhWtRZ5Pbjb28vL/+3UpIZ5HCahe08nJ8T6avtrxeX21lQ75V4EnIlq9sjQD//0b5O5kXgmQ4PvL+/4BE9Pjzl7RsDuju3svE8N2Hj3j/PXcmowDWfoMqYLxgXWg/qvFgycCPyLNMAYDsXuJnsfDhVtmnrNRwyOqEVIvxG4oBRHyY3D75bxA8Su0ziZsI9Y/IRRpOVtDaZCXELhpVjgTqXuCuQHjuAjDYZDnS4C2uVYJCjlLQ54oxBDmqYjIu5sxwm0PP5d1WHTWI8UnWOPIt1LqHNEtrVbd3V59rpc1hXjuiw/3p7u8ZCCGPFBZ/tZ5PZT9vXZ7O1ME8LPfPlrz/3/E36n02dyVa8ur//+/KHy4v7N5R68+DpX0+X3Kr8+8SbDfXOi85UVkC8aQLXTcUjLNUmGV+5tpksHp0B1cD6E8n09bXa6Mg4sC6V6RwynWscPT1qpulGGfuhS63qTp2quqbeN6HtXJWtBr3iPwZ1ZisuWJAThfb7uRJu1WJr/cAD48FYFzaTBMehRWAxtqiZ0uZjoYZGzXRqbLWar5IrrhSsItKNi0zGnY6hmQ5e6paRfmzDGtVZBPpx9ag8By7XxjLRvJ/RSttsht0JrfvJJFwhztfmR8KTP/vvQEkWzJe+ffsbzeY/PT09PDxtNn8uHh5oG33go63+Oln0NApH0U4nbMuoVuOJpjFGOBFi+3oskPtguohNFtFqlmugLER3bAhG1gbDU5dFs3UeHm+eAMCeDp/6YSHUmn7xRrhkfcTA7ybN3syzbYRTsaSSDJ1utZOS9AIRg6l1LqLfQAkoQ0aFjBrNC+mVVDoyhB07G8tQBq7aoJGv/BqOmxtEznJVb7GlqrxiO6K96QDRpNLgWR2Hdxsqz4QlIBUxBsZh1Ys90TxhoGck3LOZCHhUalcrbrec59Eq7y99RA4zDfT5hVNL1tcpXIwOF2I5zmf1LjTYKOPp4+5sr0Yb9EnraOZ2Awdcw6GeeCKNaebEF8MSpzwddLwRmDan0Z+u5Q65h1pbruirtUPgnvU4cHd1wacisIbbqFWRxUrXhHDdpq3BmEPbIEaBeR8aXaMCUJJnjjTmmC3S5si17+11Z+WEQz6Nm0quHYD6zQXPHMu/aKl0tNFlotAMNNFcC9KPqYWOGAqXgvOaeBZ+J3uimcUZ64zZFuFmortUIzhBqIdF3o/wivOSxzmq8IOfRI67PiMu5DYU5wOmg9XgWfSgx/Piet4FmYbZCeCC5n4Z6FKHaqemzrAbufbA09HizOaS+NwiOJoP4lGhroWoxTo4T9Gn1eIw6vjLEK9CgvUsg2+W4lBdR5w441tayzR6YrpS42fO6mTWWSae0U2Jo2/XHYgJVsWYJz+xO+qndNSQPxzhASNcH30v4oZ0Z6jDaTQz/WgdQqP5y1kCM4Der4HzbK8AM9cTQC3nMxJsUnF+QpdmTzIPfABmjCe2kg8VVjSPT/po5pyOd0I5yGXhBlZDXk6jOpoPkpQA9TzoM40uOq7nXcBFsYG5S0HyxINwg0IniTO34SrjnGGyTfPiwdHedfcxtdV4DulQ4w3MlbDbUD3mtVWfXMc6MFZ/MXqGbc4OQmc3sEE+o1SW6cViedb62o0yNzAUUBJ5+SuR1q9QOhrZhncRVGM/eVQT3/MUaunesFS3XqlFFuNkEtHGBvpLR6DX68l6BuscHPPknbiMvdNnBlx0Gi4bGUgf1Y+320Shg4SEmjsdH0gsh1aFB+Z5w0EH/Y83otP2eVU1jHmPFUEIQrodfCC9WWqk0+yj7DjqaD+UZzujI0W0P+dW6kgPCW53sFMG1PEM/BJgGu5glpMhBtgiu5LK1Vr0xTLQXEZkuc2s6e0n6ZKlXbYxd5bPpwKNwaKYJytPMUekx2qXdSS/EpwrL6qdvQoqDVyVWCM3uTCaF4dU94h1V6drVxlSUfhTZFo89JpUFy5axTmFGTxjYdchcQgzzV6cM+ovzLOMMNUCdPgxKEVqcz9BcOChF9+y1AfCQC/zpnPkzsauhc9OTj/Yk1RyRCWHIIcWu9Q4T8YetHVMGOsIkPAsuA9xbrLtfAplIGZHMh6Ms9GiWiI3OZ5ECjzmNnYAB2l0YVdyiZtbC5wdwQnLRV5oBRj0OEc7ENbZfXrNqeKglQqPMSSbXQeDrETLC+xhHkFkj5mOxtGbKLG7qVGco34dOJCkSGzb1FLXqZV2XlqQRk7H2msIJ/bj2bsg3e9wdYbQY6Y2erV2Cm0TeZFoUui8THmenzwcphyj7mTHInktUlPCBntz6KxxrITF1Eipi27uETWbiFZ/PS1BtAxEWDgtA228TjExYdOacv/pEC1Erin58D8qKd103DAZXc5iCJdlLlzudgAtkhNmWZUV4swfk5TlS9pZhh9wqXpccB43LQvNNaQnyGwHhMmpMc5I9Mm3XScHDOJzsVKQRxHoLtIUQyUC3CRaPPLpbHrH6B/Trij5ViUmRNetxtJpcai97yjRhjSqvz22iSL8L0J6ostes45zIH3GRN8mQNfCs+gzohhtpNJPe3JMmRf6nzfC09eCsunYkOFjLeMRwjbTwe+RKLJ8/4Rm1K7jUkj+wQeM6qnUicuA7zJsGbjOh3qprKvNNqEmp3FK53RUK5T4lFU9S+bt9eAoNRRcOBvKx5BOxjby7ujGknEmmkveKZd0SWSD5auwLgPd4XJ+RexehW3MLwe/VIzv3VizDPjlO9mqaJwPME4uOxXRLrxru8XOvZ27ReqtG3sgPWwXbtglljqOLSWxtGsbugrxi0Z3q4g0Z9BOm7G+C86a1r1k0pZ1zNbipFdnUaRVo0E0fTi2WhY6ok8WD31HrPRCLi/HyB8eT61E2SGk6csjkE7fhTW8Nzq2pFpNc0v6DRSJRodTKaa6tIyadHiYB5aJ5xtVY9lgOABouO8mPOA0uxnSBsMcML4ZDG7gOpTSgfkN5jjvHN/nl5tsmete7JL0zemXEOUr5yawCMXFVViLqzmwLTC9VfErRN9TQATzqzWWV43OUZkEigPJd1y/3DXobyF12nh4zTYsYh0EGdrRW7bTkWAn2IkP2fFGDwTwdFOEuqpconfItEs8gt1daYC39vHdfr3fu6xjTz7j3TPW52cTs9NsOiaSXDvfQb8mB0hrfdoq1bAcfFDsP4sFth4L7Saj4zRHnOrQvuGmqnq+90cb4lVxXfQvbgxPU+oiYTrIHSk0UUp/7Ec2qQjBstSB6pNp/4a2m0G4d5DheXHWMxrl4VEJvsneyx79Ha2sfU//RNsBuYwzRlN0s8P/YYn/Q4A7MLwsbW7jqmRBDspMl7TSVboeqsGwQyf21VeI8aRHdUdboPeuuWNvN7rbBNTMQOyM3O5sWYRVqsMo6wrzTt7cnezunKLbb8AGHxXh12oM65H4aKo/2lSjH0Wkk8BjJiWiGg/nqMVVI8ATlUZ9eO6yjsRHa/Yqs1OlI/r/ReZz+BeAgUIAAAAASUVORK5CYII=", "782b6449b86f180c6ebbb983cc444d51")
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -94,7 +94,7 @@ func TestNewNewsMessage(t *testing.T) {
 			SetPicUrl("http://res.mail.qq.com/node/ww/wwopenmng/images/independent/doc/test_pic_msg1.png"),
 	)
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -109,7 +109,7 @@ func TestNewNewsMessage(t *testing.T) {
 func TestNewFileMessage(t *testing.T) {
 	message := NewFileMessage("3a8asd892asd8asd")
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -151,7 +151,7 @@ func TestNewCardTextNoticeMessage(t *testing.T) {
 			SetUrl("https://work.weixin.qq.com/?from=openApi"),
 	)
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -192,7 +192,7 @@ func TestNewCardNewsNoticeMessage(t *testing.T) {
 			SetUrl("https://work.weixin.qq.com/?from=openApi"),
 	)
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessage(message)
 	if err != nil {
 		t.Error("send message error", err)
@@ -203,3 +203,21 @@ func TestNewCardNewsNoticeMessage(t *testing.T) {
 		t.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
 	}
 }
+
+func TestMarkdownMessage_AddMention(t *testing.T) {
+	message := NewMarkdownMessage("部署失败 <@zhangsan>").
+		AddMention("zhangsan", "lisi", "lisi").
+		AddMention("wangwu").
+		MentionAll().
+		MentionAll()
+	if !reflect.DeepEqual(message.Mentions, []string{"lisi", "wangwu", "@all"}) {
+		t.Errorf("mentions = %v", message.Mentions)
+	}
+	content := message.ToMessageMap()["markdown"].(map[string]interface{})["content"]
+	if content != "部署失败 <@zhangsan>\n<@lisi><@wangwu><@all>" {
+		t.Errorf("content = %q", content)
+	}
+	if content := NewMarkdownMessage("无提醒").ToMessageMap()["markdown"].(map[string]interface{})["content"]; content != "无提醒" {
+		t.Errorf("content = %q", content)
+	}
+}
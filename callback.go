@@ -0,0 +1,264 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/group-robot/work-weixin-robot/wxbizmsgcrypt"
+)
+
+var (
+	// ErrInvalidSignature 回调签名校验失败
+	ErrInvalidSignature = wxbizmsgcrypt.ErrInvalidSignature
+	// ErrInvalidCiphertext 回调消息解密失败
+	ErrInvalidCiphertext = wxbizmsgcrypt.ErrInvalidCiphertext
+	// ErrInvalidReceiveId 回调消息 receiveid 不匹配
+	ErrInvalidReceiveId = wxbizmsgcrypt.ErrInvalidReceiveId
+)
+
+// 回调消息类型
+const (
+	// TextCallbackType 文本消息
+	TextCallbackType = "text"
+	// EventCallbackType 事件消息
+	EventCallbackType = "event"
+)
+
+// CallbackFrom 回调消息发送者
+type CallbackFrom struct {
+	// UserId 发送者 userid
+	UserId string `xml:"UserId" json:"userid"`
+	// Name 发送者姓名
+	Name string `xml:"Name" json:"name"`
+	// Alias 发送者别名
+	Alias string `xml:"Alias" json:"alias"`
+}
+
+// CallbackText 回调文本消息内容
+type CallbackText struct {
+	// Content 文本内容，包含 @机器人
+	Content string `xml:"Content" json:"content"`
+}
+
+// CallbackEvent 回调事件
+type CallbackEvent struct {
+	// EventType 事件类型，如 add_to_chat、delete_from_chat、enter_chat
+	EventType string `xml:"EventType" json:"eventtype"`
+}
+
+// CallbackMessage 机器人回调消息
+type CallbackMessage struct {
+	XMLName xml.Name `xml:"xml" json:"-"`
+	// WebhookUrl 回复该会话的 webhook 地址
+	WebhookUrl string `xml:"WebhookUrl" json:"webhookurl"`
+	// ChatId 会话 id
+	ChatId string `xml:"ChatId" json:"chatid"`
+	// ChatType 会话类型，single 单聊、group 群聊
+	ChatType string `xml:"ChatType" json:"chattype"`
+	// GetChatInfoUrl 获取群信息的地址
+	GetChatInfoUrl string `xml:"GetChatInfoUrl" json:"getchatinfourl"`
+	// From 发送者
+	From CallbackFrom `xml:"From" json:"from"`
+	// MsgType 消息类型，text、event 等
+	MsgType string `xml:"MsgType" json:"msgtype"`
+	// MsgId 消息 id
+	MsgId string `xml:"MsgId" json:"msgid"`
+	// Text MsgType 为 text 时的内容
+	Text *CallbackText `xml:"Text" json:"text"`
+	// Event MsgType 为 event 时的内容
+	Event *CallbackEvent `xml:"Event" json:"event"`
+}
+
+// ParseCallbackMessage 解析解密后的回调消息，支持 XML 及 JSON 格式
+func ParseCallbackMessage(data []byte) (*CallbackMessage, error) {
+	message := &CallbackMessage{}
+	var err error
+	if isJSON(data) {
+		err = json.Unmarshal(data, message)
+	} else {
+		err = xml.Unmarshal(data, message)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// isJSON 首个非空白字符为 { 时视为 JSON
+func isJSON(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '{'
+}
+
+// CallbackHandler 处理回调消息
+type CallbackHandler interface {
+	HandleCallback(ctx context.Context, message *CallbackMessage) error
+}
+
+// CallbackHandlerFunc 函数形式的 CallbackHandler
+type CallbackHandlerFunc func(ctx context.Context, message *CallbackMessage) error
+
+// HandleCallback CallbackHandler
+func (f CallbackHandlerFunc) HandleCallback(ctx context.Context, message *CallbackMessage) error {
+	return f(ctx, message)
+}
+
+// callbackEnvelope 回调请求体，加密消息在 Encrypt 中
+type callbackEnvelope struct {
+	XMLName xml.Name `xml:"xml" json:"-"`
+	Encrypt string   `xml:"Encrypt" json:"encrypt"`
+}
+
+// CallbackServer 机器人回调服务，实现 http.Handler：
+// GET 请求校验 URL 并返回解密后的 echostr，POST 请求校验签名、解密并交由 CallbackHandler 处理，
+// handler 实现 CallbackReplyHandler 时返回加密的被动回复
+type CallbackServer struct {
+	crypt   *wxbizmsgcrypt.MsgCrypt
+	handler CallbackHandler
+	onError func(error)
+}
+
+// NewCallbackServer create CallbackServer, token、encodingAESKey 为机器人回调配置中的 Token、EncodingAESKey
+func NewCallbackServer(token, encodingAESKey string, handler CallbackHandler) (*CallbackServer, error) {
+	crypt, err := wxbizmsgcrypt.New(token, encodingAESKey, "")
+	if err != nil {
+		return nil, err
+	}
+	return &CallbackServer{crypt: crypt, handler: handler, onError: func(error) {}}, nil
+}
+
+// SetReceiveId 校验解密后消息的 receiveid，默认不校验
+func (server *CallbackServer) SetReceiveId(receiveId string) *CallbackServer {
+	server.crypt = server.crypt.WithReceiveId(receiveId)
+	return server
+}
+
+// SetErrorHandler 请求处理失败时的回调
+func (server *CallbackServer) SetErrorHandler(handler func(error)) *CallbackServer {
+	server.onError = handler
+	return server
+}
+
+// ServeHTTP http.Handler
+func (server *CallbackServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		server.verifyURL(w, r)
+	case http.MethodPost:
+		server.receive(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// verifyURL 校验回调 URL，返回解密后的 echostr
+func (server *CallbackServer) verifyURL(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	echostr := query.Get("echostr")
+	plaintext, err := server.open(query.Get("msg_signature"), query.Get("timestamp"), query.Get("nonce"), echostr)
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write(plaintext)
+}
+
+// receive 接收回调消息
+func (server *CallbackServer) receive(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	envelope := &callbackEnvelope{}
+	jsonBody := isJSON(body)
+	if jsonBody {
+		err = json.Unmarshal(body, envelope)
+	} else {
+		err = xml.Unmarshal(body, envelope)
+	}
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	query := r.URL.Query()
+	plaintext, err := server.open(query.Get("msg_signature"), query.Get("timestamp"), query.Get("nonce"), envelope.Encrypt)
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	message, err := ParseCallbackMessage(plaintext)
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	replier, ok := server.handler.(CallbackReplyHandler)
+	if !ok {
+		if err = server.handler.HandleCallback(r.Context(), message); err != nil {
+			server.internalError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	reply, err := replier.ReplyCallback(r.Context(), message)
+	if err != nil {
+		server.internalError(w, err)
+		return
+	}
+	if reply == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	server.writeReply(w, reply, query.Get("timestamp"), query.Get("nonce"), jsonBody)
+}
+
+// writeReply 加密回复并按请求格式写入 XML 或 JSON
+func (server *CallbackServer) writeReply(w http.ResponseWriter, message Message, timestamp, nonce string, jsonBody bool) {
+	reply, err := server.EncryptReply(message, timestamp, nonce)
+	if err != nil {
+		server.internalError(w, err)
+		return
+	}
+	var data []byte
+	if jsonBody {
+		w.Header().Set("Content-Type", "application/json")
+		data, err = json.Marshal(reply)
+	} else {
+		w.Header().Set("Content-Type", "application/xml")
+		data, err = xml.Marshal(reply)
+	}
+	if err != nil {
+		server.internalError(w, err)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// open 校验签名并解密
+func (server *CallbackServer) open(signature, timestamp, nonce, encrypt string) ([]byte, error) {
+	return server.crypt.DecryptMsg(signature, timestamp, nonce, encrypt)
+}
+
+// internalError 处理回调失败返回 500
+func (server *CallbackServer) internalError(w http.ResponseWriter, err error) {
+	server.onError(err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// fail 签名错误返回 403，其他错误返回 400
+func (server *CallbackServer) fail(w http.ResponseWriter, err error) {
+	server.onError(err)
+	status := http.StatusBadRequest
+	if errors.Is(err, ErrInvalidSignature) {
+		status = http.StatusForbidden
+	}
+	http.Error(w, http.StatusText(status), status)
+}
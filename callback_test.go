@@ -0,0 +1,130 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/group-robot/work-weixin-robot/wxbizmsgcrypt"
+)
+
+const (
+	testCallbackToken  = "QDG6eK"
+	testEncodingAESKey = "jWmYm7qr5nMoAUwZRjGtBxmz3KA1tkAj3ykkR6q2B2C"
+)
+
+// newCallbackRequest 加密 plaintext 并构造签名后的回调请求
+func newCallbackRequest(t *testing.T, method, plaintext string) *http.Request {
+	crypt, err := wxbizmsgcrypt.New(testCallbackToken, testEncodingAESKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypt, err := crypt.Encrypt([]byte(plaintext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := url.Values{}
+	query.Set("msg_signature", crypt.Signature("1409659589", "263014780", encrypt))
+	query.Set("timestamp", "1409659589")
+	query.Set("nonce", "263014780")
+	if method == http.MethodGet {
+		query.Set("echostr", encrypt)
+		return httptest.NewRequest(method, "/callback?"+query.Encode(), nil)
+	}
+	body := "<xml><Encrypt><![CDATA[" + encrypt + "]]></Encrypt></xml>"
+	return httptest.NewRequest(method, "/callback?"+query.Encode(), strings.NewReader(body))
+}
+
+func TestCallbackServer_VerifyURL(t *testing.T) {
+	server, err := NewCallbackServer(testCallbackToken, testEncodingAESKey, CallbackHandlerFunc(func(context.Context, *CallbackMessage) error {
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, newCallbackRequest(t, http.MethodGet, "1616140317555161061"))
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "1616140317555161061" {
+		t.Errorf("response = %d %q", recorder.Code, recorder.Body.String())
+	}
+
+	request := newCallbackRequest(t, http.MethodGet, "1616140317555161061")
+	query := request.URL.Query()
+	query.Set("timestamp", "1409659590")
+	request.URL.RawQuery = query.Encode()
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("invalid signature: status = %d", recorder.Code)
+	}
+}
+
+func TestCallbackServer_Text(t *testing.T) {
+	var received *CallbackMessage
+	server, _ := NewCallbackServer(testCallbackToken, testEncodingAESKey, CallbackHandlerFunc(func(ctx context.Context, message *CallbackMessage) error {
+		received = message
+		return nil
+	}))
+	plaintext := `<xml>
+<WebhookUrl><![CDATA[https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=xxx]]></WebhookUrl>
+<ChatId><![CDATA[wrkSFfCgAALFgnrSsWU38puiv4yvExuw]]></ChatId>
+<ChatType>group</ChatType>
+<From>
+	<UserId>zhangsan</UserId>
+	<Name><![CDATA[张三]]></Name>
+	<Alias><![CDATA[san]]></Alias>
+</From>
+<MsgType>text</MsgType>
+<Text><Content><![CDATA[@RobotA deploy api prod]]></Content></Text>
+<MsgId>abcdabcdabcd</MsgId>
+</xml>`
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, newCallbackRequest(t, http.MethodPost, plaintext))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if received == nil {
+		t.Fatal("callback not handled")
+	}
+	if received.MsgType != TextCallbackType || received.Text == nil || received.Text.Content != "@RobotA deploy api prod" {
+		t.Errorf("message = %+v", received)
+	}
+	if received.From.UserId != "zhangsan" || received.From.Name != "张三" || received.ChatType != "group" {
+		t.Errorf("from = %+v, chat type = %s", received.From, received.ChatType)
+	}
+	if received.WebhookUrl != "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=xxx" {
+		t.Errorf("webhook url = %s", received.WebhookUrl)
+	}
+}
+
+func TestCallbackServer_HandlerError(t *testing.T) {
+	var handled error
+	server, _ := NewCallbackServer(testCallbackToken, testEncodingAESKey, CallbackHandlerFunc(func(ctx context.Context, message *CallbackMessage) error {
+		return errors.New("boom")
+	}))
+	server.SetErrorHandler(func(err error) {
+		handled = err
+	})
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, newCallbackRequest(t, http.MethodPost, `{"msgtype":"event","event":{"eventtype":"add_to_chat"}}`))
+	if recorder.Code != http.StatusInternalServerError || handled == nil {
+		t.Errorf("status = %d, err = %v", recorder.Code, handled)
+	}
+}
+
+func TestParseCallbackMessage(t *testing.T) {
+	message, err := ParseCallbackMessage([]byte(`{"chatid":"wrk","from":{"userid":"lisi"},"msgtype":"event","event":{"eventtype":"add_to_chat"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.MsgType != EventCallbackType || message.Event == nil || message.Event.EventType != "add_to_chat" || message.From.UserId != "lisi" {
+		t.Errorf("message = %+v", message)
+	}
+	if _, err := ParseCallbackMessage([]byte("<xml><MsgType>")); err == nil {
+		t.Error("invalid xml parsed")
+	}
+}
@@ -1,29 +1,46 @@
 package work_weixin_robot
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/go-resty/resty/v2"
 )
 
 // WorkWeixinRobotClient 企业微信-机器人客户端
 type WorkWeixinRobotClient struct {
 	// Webhook webhook address
-	Webhook string
-	client  *resty.Client
+	Webhook     string
+	client      *resty.Client
+	middlewares []Middleware
+	retry       retryOptions
+	log         *robotLog
+	dryRun      *dryRun
 }
 
 // NewRobotClient create WorkWeixinRobotClient
-func NewRobotClient() *WorkWeixinRobotClient {
-	return NewRobotClientByWebHook("")
+func NewRobotClient(opts ...ClientOption) *WorkWeixinRobotClient {
+	return NewRobotClientByWebHook("", opts...)
 }
 
 // NewRobotClientByWebHook create WorkWeixinRobotClient By Webhook
-func NewRobotClientByWebHook(webhook string) *WorkWeixinRobotClient {
+func NewRobotClientByWebHook(webhook string, opts ...ClientOption) *WorkWeixinRobotClient {
+	options := newClientOptions(opts...)
 	return &WorkWeixinRobotClient{
-		Webhook: webhook,
-		client:  resty.New(),
+		Webhook:     webhook,
+		client:      options.restyClient(),
+		middlewares: options.middlewares,
+		retry:       options.retry,
+		log:         options.log,
+		dryRun:      options.dryRun,
 	}
 }
 
+// String webhook key redacted
+func (client *WorkWeixinRobotClient) String() string {
+	return fmt.Sprintf("WorkWeixinRobotClient{Webhook: %s}", RedactWebhook(client.Webhook))
+}
+
 // RobotResponse robot response
 type RobotResponse struct {
 	ErrCode int    `json:"errcode"`
@@ -37,34 +54,68 @@ func (rep *RobotResponse) IsSuccess() bool {
 
 // SendMessage send message
 func (client *WorkWeixinRobotClient) SendMessage(message Message) (*RobotResponse, error) {
-	return client.SendMessageByUrl(client.Webhook, message)
+	return client.SendMessageContext(context.Background(), message)
+}
+
+// SendMessageContext send message with context
+func (client *WorkWeixinRobotClient) SendMessageContext(ctx context.Context, message Message) (*RobotResponse, error) {
+	return client.SendMessageByUrlContext(ctx, client.Webhook, message)
 }
 
 // SendMessageStr send message json string
 func (client *WorkWeixinRobotClient) SendMessageStr(message string) (*RobotResponse, error) {
-	return client.SendMessageStrByUrl(client.Webhook, message)
+	return client.SendMessageStrContext(context.Background(), message)
+}
+
+// SendMessageStrContext send message json string with context
+func (client *WorkWeixinRobotClient) SendMessageStrContext(ctx context.Context, message string) (*RobotResponse, error) {
+	return client.SendMessageStrByUrlContext(ctx, client.Webhook, message)
 }
 
 // SendMessageByUrl send message custom url
 func (client *WorkWeixinRobotClient) SendMessageByUrl(url string, message Message) (*RobotResponse, error) {
-	return client.send(url, message.ToMessageMap())
+	return client.SendMessageByUrlContext(context.Background(), url, message)
+}
 
+// SendMessageByUrlContext send message custom url with context
+func (client *WorkWeixinRobotClient) SendMessageByUrlContext(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+	request, err := newRobotRequest(url, message)
+	if err != nil {
+		return nil, err
+	}
+	return client.send(ctx, request)
 }
 
 // SendMessageStrByUrl send message custom url and json string message
 func (client *WorkWeixinRobotClient) SendMessageStrByUrl(url, message string) (*RobotResponse, error) {
-	return client.send(url, message)
+	return client.SendMessageStrByUrlContext(context.Background(), url, message)
 }
 
-func (client *WorkWeixinRobotClient) send(url string, body interface{}) (*RobotResponse, error) {
+// SendMessageStrByUrlContext send message custom url and json string message with context
+func (client *WorkWeixinRobotClient) SendMessageStrByUrlContext(ctx context.Context, url, message string) (*RobotResponse, error) {
+	return client.send(ctx, &RobotRequest{Url: url, Body: []byte(message)})
+}
+
+func (client *WorkWeixinRobotClient) send(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+	if client.dryRun != nil {
+		return chain(client.dryRun.post, client.middlewares...)(ctx, request)
+	}
+	return chain(client.retry.retry(client.post, client.log.retry), client.middlewares...)(ctx, request)
+}
+
+func (client *WorkWeixinRobotClient) post(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
 	resp, err := client.client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		ForceContentType("application/json").
-		SetBody(body).
+		SetBody(request.Body).
 		SetResult(&RobotResponse{}).
-		Post(url)
+		Post(request.Url)
 	if err != nil {
-		return nil, err
+		return nil, redactError(err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("work_weixin_robot: unexpected http status: %s", resp.Status())
 	}
 	result := resp.Result().(*RobotResponse)
 	return result, nil
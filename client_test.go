@@ -3,8 +3,20 @@ package work_weixin_robot
 import (
 	"os"
 	"testing"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
 )
 
+// webhookForTest 环境变量 webhook 未设置时使用 robottest 模拟服务
+func webhookForTest(t *testing.T) string {
+	if webhook := os.Getenv("webhook"); webhook != "" {
+		return webhook
+	}
+	server := robottest.NewServer()
+	t.Cleanup(server.Close)
+	return server.Webhook()
+}
+
 func TestWorkWeixinRobotClient_SendMessageStr(t *testing.T) {
 	json := `{
     "msgtype":"template_card",
@@ -72,7 +84,7 @@ func TestWorkWeixinRobotClient_SendMessageStr(t *testing.T) {
     }
 }`
 	client := NewRobotClient()
-	client.Webhook = os.Getenv("webhook")
+	client.Webhook = webhookForTest(t)
 	res, err := client.SendMessageStr(json)
 	if err != nil {
 		t.Error("send message error", err)
@@ -0,0 +1,30 @@
+package work_weixin_robot
+
+import "time"
+
+// Timer 定时器
+type Timer interface {
+	// Stop 停止定时器，定时器已触发或已停止时返回 false
+	Stop() bool
+}
+
+// Clock 时钟，可注入以便测试
+type Clock interface {
+	// Now 当前时间
+	Now() time.Time
+	// AfterFunc 在 d 之后于新的 goroutine 中调用 f
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// SystemClock 系统时钟
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
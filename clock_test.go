@@ -0,0 +1,95 @@
+package work_weixin_robot
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// fakeClock Clock for tests, 仅在 Advance 时触发定时器
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock   *fakeClock
+	when    time.Time
+	f       func()
+	stopped bool
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	timer := &fakeTimer{clock: clock, when: clock.now.Add(d), f: f}
+	clock.timers = append(clock.timers, timer)
+	return timer
+}
+
+func (timer *fakeTimer) Stop() bool {
+	timer.clock.mu.Lock()
+	defer timer.clock.mu.Unlock()
+	if timer.stopped {
+		return false
+	}
+	timer.stopped = true
+	return true
+}
+
+// Advance 前进 d 并按时间顺序同步触发到期的定时器
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.advance(d, false)
+}
+
+// Jump 时钟直接跳到 d 之后再触发到期的定时器，模拟进程挂起
+func (clock *fakeClock) Jump(d time.Duration) {
+	clock.advance(d, true)
+}
+
+func (clock *fakeClock) advance(d time.Duration, jump bool) {
+	clock.mu.Lock()
+	end := clock.now.Add(d)
+	if jump {
+		clock.now = end
+	}
+	clock.mu.Unlock()
+	for {
+		clock.mu.Lock()
+		sort.SliceStable(clock.timers, func(i, j int) bool { return clock.timers[i].when.Before(clock.timers[j].when) })
+		var next *fakeTimer
+		for i, timer := range clock.timers {
+			if timer.stopped {
+				continue
+			}
+			if timer.when.After(end) {
+				break
+			}
+			next = timer
+			clock.timers = append(clock.timers[:i], clock.timers[i+1:]...)
+			break
+		}
+		if next == nil {
+			clock.now = end
+			clock.mu.Unlock()
+			return
+		}
+		next.stopped = true
+		if next.when.After(clock.now) {
+			clock.now = next.when
+		}
+		clock.mu.Unlock()
+		next.f()
+	}
+}
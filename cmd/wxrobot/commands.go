@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"gopkg.in/yaml.v3"
+)
+
+// stringsFlag 可重复的参数
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+var commands = []command{
+	{name: "text", args: "[content|-]", summary: "send a text message", flags: textFlags},
+	{name: "markdown", args: "[content|-]", summary: "send a markdown message", flags: markdownFlags},
+	{name: "image", args: "<path|->", summary: "send a jpg/png image, at most 2MB", flags: imageFlags},
+	{name: "file", args: "<path>", summary: "upload a file and send it", flags: fileFlags},
+	{name: "news", args: "", summary: "send a news message with one article", flags: newsFlags},
+	{name: "card", args: "<spec|->", summary: "send a template card from a JSON or YAML spec", flags: cardFlags},
+	{name: "pipe", args: "", summary: "forward stdin lines in batches within the rate limit", flags: pipeFlags},
+	{name: "exec", args: "-- <command> [args]", summary: "run a command and post its result as a card", flags: execFlags},
+}
+
+func textFlags(fs *flag.FlagSet) builder {
+	var mentions, mobiles stringsFlag
+	fs.Var(&mentions, "mention", "mention a member by userid, repeatable")
+	fs.Var(&mobiles, "mention-mobile", "mention a member by mobile, repeatable")
+	atAll := fs.Bool("at-all", false, "mention all members")
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		content, err := c.content(args)
+		if err != nil {
+			return nil, err
+		}
+		message := robot.NewTextMessage(content).AddUserIds(mentions...).AddMobiles(mobiles...)
+		if *atAll {
+			message.AddUserIds(robot.MentionAllUserId)
+		}
+		return message, nil
+	}
+}
+
+func markdownFlags(fs *flag.FlagSet) builder {
+	var mentions stringsFlag
+	fs.Var(&mentions, "mention", "mention a member by userid, repeatable")
+	atAll := fs.Bool("at-all", false, "mention all members")
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		content, err := c.content(args)
+		if err != nil {
+			return nil, err
+		}
+		message := robot.NewMarkdownMessage(content).AddMention(mentions...)
+		if *atAll {
+			message.MentionAll()
+		}
+		return message, nil
+	}
+}
+
+func imageFlags(fs *flag.FlagSet) builder {
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%w: image path required", errUsage)
+		}
+		data, err := c.readFile(args[0])
+		if err != nil {
+			return nil, err
+		}
+		sum := md5.Sum(data)
+		return robot.NewImageMessage(base64.StdEncoding.EncodeToString(data), hex.EncodeToString(sum[:])), nil
+	}
+}
+
+func fileFlags(fs *flag.FlagSet) builder {
+	name := fs.String("name", "", "file name shown in the group (default base name of path)")
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%w: file path required", errUsage)
+		}
+		file, err := os.Open(args[0])
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		filename := *name
+		if filename == "" {
+			filename = filepath.Base(args[0])
+		}
+		res, err := c.client.UploadMediaContext(ctx, robot.FileMediaType, filename, file)
+		if err != nil {
+			return nil, err
+		}
+		if err = responseError(&res.RobotResponse); err != nil {
+			return nil, fmt.Errorf("upload: %w", err)
+		}
+		return robot.NewFileMessage(res.MediaId), nil
+	}
+}
+
+func newsFlags(fs *flag.FlagSet) builder {
+	title := fs.String("title", "", "article title, required")
+	url := fs.String("url", "", "article url, required")
+	desc := fs.String("desc", "", "article description")
+	picUrl := fs.String("picurl", "", "article picture url")
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		if *title == "" || *url == "" {
+			return nil, fmt.Errorf("%w: --title and --url required", errUsage)
+		}
+		if len(args) > 0 {
+			return nil, fmt.Errorf("%w: unexpected arguments %q", errUsage, args)
+		}
+		return robot.NewNewsMessage(robot.NewArticle(*title, *url).SetDesc(*desc).SetPicUrl(*picUrl)), nil
+	}
+}
+
+func cardFlags(fs *flag.FlagSet) builder {
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%w: card spec required", errUsage)
+		}
+		data, err := c.readFile(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return parseCardSpec(data)
+	}
+}
+
+// parseCardSpec 解析 JSON 或 YAML 格式的模版卡片，字段与 webhook 接口的 template_card 一致，
+// 也可以是包含 msgtype 的完整消息
+func parseCardSpec(data []byte) (robot.Message, error) {
+	spec := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("card spec: %w", err)
+	}
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("card spec: empty")
+	}
+	if _, ok := spec["msgtype"]; !ok {
+		if _, ok = spec["template_card"]; ok {
+			spec["msgtype"] = robot.TemplateCardMsgType
+		} else {
+			spec = map[string]interface{}{"msgtype": robot.TemplateCardMsgType, "template_card": spec}
+		}
+	}
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("card spec: %w", err)
+	}
+	return robot.RawMessage(body), nil
+}
+
+// content 消息内容，参数为空或为 - 时从标准输入读取
+func (c *cli) content(args []string) (string, error) {
+	var content string
+	if len(args) == 0 || len(args) == 1 && args[0] == "-" {
+		data, err := ioutil.ReadAll(c.stdin)
+		if err != nil {
+			return "", err
+		}
+		content = strings.TrimRight(string(data), "\r\n")
+	} else {
+		content = strings.Join(args, " ")
+	}
+	if strings.TrimSpace(content) == "" {
+		return "", fmt.Errorf("%w: empty content", errUsage)
+	}
+	return content, nil
+}
+
+// readFile 读取文件，path 为 - 时从标准输入读取
+func (c *cli) readFile(path string) ([]byte, error) {
+	var r io.Reader = c.stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// webhookPrefix 只指定 key 时的 webhook 地址
+const webhookPrefix = "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key="
+
+// 环境变量
+const (
+	webhookEnv = "WXROBOT_WEBHOOK"
+	configEnv  = "WXROBOT_CONFIG"
+)
+
+// config 配置文件
+type config struct {
+	// Webhook webhook 地址或 key
+	Webhook string `yaml:"webhook"`
+}
+
+// resolveWebhook 依次取自参数、环境变量及配置文件，只有 key 时补全为 webhook 地址
+func resolveWebhook(flagWebhook, configPath string) (string, error) {
+	webhook := flagWebhook
+	if webhook == "" {
+		webhook = os.Getenv(webhookEnv)
+	}
+	if webhook == "" {
+		cfg, err := loadConfig(configPath)
+		if err != nil {
+			return "", err
+		}
+		webhook = cfg.Webhook
+	}
+	webhook = strings.TrimSpace(webhook)
+	if webhook == "" {
+		return "", fmt.Errorf("no webhook: use --webhook, $%s or a config file", webhookEnv)
+	}
+	if !strings.Contains(webhook, "://") {
+		webhook = webhookPrefix + webhook
+	}
+	return webhook, nil
+}
+
+// loadConfig 读取配置文件，未指定路径且默认配置文件不存在时返回空配置
+func loadConfig(path string) (*config, error) {
+	explicit := path != ""
+	if path == "" {
+		path = os.Getenv(configEnv)
+		explicit = path != ""
+	}
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return &config{}, nil
+		}
+		path = filepath.Join(dir, "wxrobot", "config.yaml")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, os.ErrNotExist) {
+			return &config{}, nil
+		}
+		return nil, err
+	}
+	cfg := &config{}
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	return cfg, nil
+}
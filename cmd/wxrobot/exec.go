@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"github.com/group-robot/work-weixin-robot/internal/batch"
+)
+
+// notify-on
+const (
+	notifyAlways  = "always"
+	notifyFailure = "failure"
+	notifyChange  = "change"
+)
+
+// exitCodeError 以指定的退出码退出，不输出错误
+type exitCodeError struct {
+	code int
+}
+
+func (e *exitCodeError) Error() string {
+	return "exit status " + strconv.Itoa(e.code)
+}
+
+// execResult 命令的执行结果
+type execResult struct {
+	command  string
+	hostname string
+	start    time.Time
+	duration time.Duration
+	exitCode int
+	// signal 终止命令的信号
+	signal os.Signal
+	err    error
+	output string
+}
+
+// success 命令是否成功
+func (result *execResult) success() bool {
+	return result.err == nil && result.exitCode == 0
+}
+
+func execFlags(fs *flag.FlagSet) builder {
+	notifyOn := fs.String("notify-on", notifyAlways, "when to notify: always, failure or change (status differs from the last run)")
+	title := fs.String("title", "", "card title (default the command line)")
+	url := fs.String("url", "https://work.weixin.qq.com/", "url opened when the card is clicked, e.g. the job log")
+	tailLines := fs.Int("tail-lines", 10, "number of output lines in the card")
+	maxOutput := fs.Int("max-output", 1024, "max bytes of output in the card")
+	state := fs.String("state", "", "file storing the last status for --notify-on change (default in the user cache dir)")
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("%w: command required", errUsage)
+		}
+		switch *notifyOn {
+		case notifyAlways, notifyFailure, notifyChange:
+		default:
+			return nil, fmt.Errorf("%w: invalid --notify-on %q", errUsage, *notifyOn)
+		}
+		result := runCommand(c, args, *maxOutput)
+
+		notify := *notifyOn == notifyAlways || *notifyOn == notifyFailure && !result.success()
+		if *notifyOn == notifyChange {
+			path := *state
+			if path == "" {
+				path = defaultStatePath(args)
+			}
+			changed, err := statusChanged(path, result.success())
+			if err != nil {
+				fmt.Fprintf(c.stderr, "wxrobot exec: state: %v\n", err)
+			}
+			notify = changed
+		}
+		if notify {
+			if *title != "" {
+				result.command = *title
+			}
+			if err := c.send(ctx, execCard(result, *url, *tailLines, *maxOutput)); err != nil {
+				fmt.Fprintf(c.stderr, "wxrobot exec: notify: %v\n", err)
+				if result.success() {
+					return nil, &exitCodeError{code: exitError}
+				}
+			}
+		}
+		if result.err != nil {
+			fmt.Fprintf(c.stderr, "wxrobot exec: %v\n", result.err)
+		}
+		if !result.success() {
+			return nil, &exitCodeError{code: result.exitCode}
+		}
+		return nil, nil
+	}
+}
+
+// runCommand 执行命令，输出同时写入标准输出及标准错误，收到的 SIGINT、SIGTERM 转发给命令
+func runCommand(c *cli, args []string, maxOutput int) *execResult {
+	result := &execResult{command: strings.Join(args, " "), start: time.Now()}
+	result.hostname, _ = os.Hostname()
+	tail := &tailBuffer{max: maxOutput}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = c.stdin
+	cmd.Stdout = teeWriter{c.stdout, tail}
+	cmd.Stderr = teeWriter{c.stderr, tail}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	if err := cmd.Start(); err != nil {
+		result.err, result.exitCode = err, 127
+	} else {
+		done := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case sig := <-signals:
+					_ = cmd.Process.Signal(sig)
+				case <-done:
+					return
+				}
+			}
+		}()
+		err = cmd.Wait()
+		close(done)
+		var exitErr *exec.ExitError
+		switch {
+		case errors.As(err, &exitErr) && exitErr.ExitCode() >= 0:
+			result.exitCode = exitErr.ExitCode()
+		case exitErr != nil && signaled(exitErr):
+			// 被信号终止，同 shell 退出码为 128+信号值
+			status := exitErr.Sys().(syscall.WaitStatus)
+			result.signal, result.exitCode = status.Signal(), 128+int(status.Signal())
+		case err != nil:
+			result.err, result.exitCode = err, exitError
+		}
+	}
+	result.duration = time.Since(result.start)
+	result.output = tail.String()
+	return result
+}
+
+// signaled 命令是否被信号终止
+func signaled(exitErr *exec.ExitError) bool {
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled()
+}
+
+// execCard 执行结果卡片：关键数据为状态，引用为输出的最后几行
+func execCard(result *execResult, url string, tailLines, maxOutput int) *robot.CardTextNoticeMessage {
+	status, color, desc := "OK", robot.GreenDescColor, "exit code 0"
+	if !result.success() {
+		status, color, desc = "FAILED", robot.RedDescColor, "exit code "+strconv.Itoa(result.exitCode)
+		if result.signal != nil {
+			desc += " (" + result.signal.String() + ")"
+		}
+		if result.err != nil {
+			desc = result.err.Error()
+		}
+	}
+	card := robot.NewCardTextNoticeMessage(
+		robot.NewCardMainTitle().SetTitle(batch.TruncateBytes(result.command, 128)).SetDesc(result.hostname),
+		robot.NewCardAction(robot.ClickUrl).SetUrl(url),
+	).SetSource(
+		robot.NewCardSource().SetDesc("wxrobot exec").SetDescColor(color),
+	).SetEmphasisContent(
+		robot.NewCardEmphasisContent().SetTitle(status).SetDesc(desc),
+	).AddHorizontalContents(
+		robot.NewCardHorizontalContent("started").SetValue(result.start.Format("2006-01-02 15:04:05")),
+		robot.NewCardHorizontalContent("duration").SetValue(formatDuration(result.duration)),
+	)
+	if output := tailOutput(result.output, tailLines, maxOutput); output != "" {
+		card.SetQuoteArea(robot.NewCardQuoteArea(robot.ClickNone).SetTitle("output").SetQuoteText(output))
+	}
+	return card
+}
+
+// formatDuration 一分钟内精确到毫秒，否则精确到秒
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
+
+// tailOutput 输出的最后 lines 行，不超过 max 字节
+func tailOutput(output string, lines, max int) string {
+	output = strings.TrimRight(output, "\r\n")
+	if output == "" {
+		return ""
+	}
+	all := strings.Split(output, "\n")
+	if lines > 0 && len(all) > lines {
+		all = all[len(all)-lines:]
+	}
+	output = strings.Join(all, "\n")
+	if len(output) > max {
+		output = output[len(output)-max:]
+		if i := strings.IndexByte(output, '\n'); i >= 0 {
+			output = output[i+1:]
+		} else {
+			for len(output) > 0 && !utf8.RuneStart(output[0]) {
+				output = output[1:]
+			}
+		}
+	}
+	return output
+}
+
+// defaultStatePath 按命令行及工作目录区分的状态文件
+func defaultStatePath(args []string) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	wd, _ := os.Getwd()
+	sum := sha1.Sum([]byte(wd + "\x00" + strings.Join(args, "\x00")))
+	return filepath.Join(dir, "wxrobot", "exec-"+hex.EncodeToString(sum[:8]))
+}
+
+// statusChanged 保存本次状态，返回与上次是否不同，没有上次状态时视为不同
+func statusChanged(path string, success bool) (bool, error) {
+	status := "failure"
+	if success {
+		status = "success"
+	}
+	last, err := ioutil.ReadFile(path)
+	changed := err != nil || strings.TrimSpace(string(last)) != status
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return changed, err
+	}
+	return changed, ioutil.WriteFile(path, []byte(status+"\n"), 0644)
+}
+
+// tailBuffer 保留最后 max 字节的输出，max 不小于 4KB 以便按行截取
+type tailBuffer struct {
+	mu   sync.Mutex
+	max  int
+	data []byte
+}
+
+func (buffer *tailBuffer) Write(p []byte) (int, error) {
+	buffer.mu.Lock()
+	defer buffer.mu.Unlock()
+	limit := buffer.max
+	if limit < 4096 {
+		limit = 4096
+	}
+	buffer.data = append(buffer.data, p...)
+	if overflow := len(buffer.data) - limit; overflow > 0 {
+		buffer.data = append(buffer.data[:0], buffer.data[overflow:]...)
+	}
+	return len(p), nil
+}
+
+func (buffer *tailBuffer) String() string {
+	buffer.mu.Lock()
+	defer buffer.mu.Unlock()
+	return string(buffer.data)
+}
+
+// teeWriter 写入 w 及 tail，w 写入失败不影响命令执行
+type teeWriter struct {
+	w    io.Writer
+	tail *tailBuffer
+}
+
+func (tee teeWriter) Write(p []byte) (int, error) {
+	_, _ = tee.w.Write(p)
+	return tee.tail.Write(p)
+}
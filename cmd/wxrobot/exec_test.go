@@ -0,0 +1,119 @@
+package main
+
+import (
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func card(t *testing.T, message map[string]interface{}) map[string]interface{} {
+	if message["msgtype"] != "template_card" {
+		t.Fatalf("message = %v", message)
+	}
+	return message["template_card"].(map[string]interface{})
+}
+
+func TestRun_Exec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	server := robottest.NewServer()
+	defer server.Close()
+
+	code, stdout, stderr := runForTest(t, "", "exec", "--webhook", server.Webhook(), "--tail-lines", "2", "--",
+		"sh", "-c", "echo oops >&2; sleep 0.05; echo one; echo two; exit 3")
+	if code != 3 {
+		t.Fatalf("code = %d, stderr = %s", code, stderr)
+	}
+	if stdout != "one\ntwo\n" || stderr != "oops\n" {
+		t.Errorf("stdout = %q, stderr = %q", stdout, stderr)
+	}
+	messages := server.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("messages = %v", messages)
+	}
+	c := card(t, messages[0])
+	emphasis := c["emphasis_content"].(map[string]interface{})
+	if emphasis["title"] != "FAILED" || emphasis["desc"] != "exit code 3" {
+		t.Errorf("emphasis = %v", emphasis)
+	}
+	if quote := c["quote_area"].(map[string]interface{}); quote["quote_text"] != "one\ntwo" {
+		t.Errorf("quote = %v", quote)
+	}
+	if title := c["main_title"].(map[string]interface{})["title"]; title != "sh -c echo oops >&2; sleep 0.05; echo one; echo two; exit 3" {
+		t.Errorf("title = %v", title)
+	}
+
+	code, _, _ = runForTest(t, "", "exec", "--webhook", server.Webhook(), "--notify-on", "failure", "--", "true")
+	if code != exitOK || len(server.Messages()) != 1 {
+		t.Errorf("code = %d, messages = %d", code, len(server.Messages()))
+	}
+	code, _, stderr = runForTest(t, "", "exec", "--webhook", server.Webhook(), "--", "wxrobot-missing-command")
+	if code != 127 || !strings.Contains(stderr, "wxrobot-missing-command") {
+		t.Errorf("code = %d, stderr = %s", code, stderr)
+	}
+}
+
+func TestRun_ExecSignal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	server := robottest.NewServer()
+	defer server.Close()
+
+	code, _, stderr := runForTest(t, "", "exec", "--webhook", server.Webhook(), "--", "sh", "-c", "kill -TERM $$")
+	if code != 143 {
+		t.Fatalf("code = %d, stderr = %s", code, stderr)
+	}
+	messages := server.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("messages = %v", messages)
+	}
+	emphasis := card(t, messages[0])["emphasis_content"].(map[string]interface{})
+	if emphasis["title"] != "FAILED" || emphasis["desc"] != "exit code 143 (terminated)" {
+		t.Errorf("emphasis = %v", emphasis)
+	}
+}
+
+func TestRun_ExecChange(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	server := robottest.NewServer()
+	defer server.Close()
+	state := filepath.Join(t.TempDir(), "backup.state")
+
+	var statuses []string
+	for _, command := range []string{"true", "true", "false", "false", "true"} {
+		runForTest(t, "", "exec", "--webhook", server.Webhook(), "--notify-on", "change", "--state", state, "--title", "backup", "--", command)
+	}
+	for _, message := range server.Messages() {
+		statuses = append(statuses, card(t, message)["emphasis_content"].(map[string]interface{})["title"].(string))
+	}
+	if strings.Join(statuses, ",") != "OK,FAILED,OK" {
+		t.Errorf("statuses = %v", statuses)
+	}
+}
+
+func TestTailOutput(t *testing.T) {
+	tests := []struct {
+		output string
+		lines  int
+		max    int
+		want   string
+	}{
+		{"a\nb\nc\n", 2, 100, "b\nc"},
+		{"a\nb\nc\n", 0, 100, "a\nb\nc"},
+		{"aaaa\nbbbb\ncccc", 10, 8, "cccc"},
+		{"很长的一行", 10, 7, "一行"},
+		{"\n\n", 10, 100, ""},
+	}
+	for _, tt := range tests {
+		if got := tailOutput(tt.output, tt.lines, tt.max); got != tt.want {
+			t.Errorf("tailOutput(%q, %d, %d) = %q, want %q", tt.output, tt.lines, tt.max, got, tt.want)
+		}
+	}
+}
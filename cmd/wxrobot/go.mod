@@ -0,0 +1,15 @@
+module github.com/group-robot/work-weixin-robot/cmd/wxrobot
+
+go 1.19
+
+require (
+	github.com/group-robot/work-weixin-robot v0.0.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/go-resty/resty/v2 v2.7.0 // indirect
+	golang.org/x/net v0.0.0-20211029224645-99673261e6eb // indirect
+)
+
+replace github.com/group-robot/work-weixin-robot => ../..
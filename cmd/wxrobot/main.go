@@ -0,0 +1,166 @@
+// Command wxrobot 发送企业微信群机器人消息，代替脚本中手写的 curl JSON：
+//
+//	wxrobot text [flags] [content]
+//	wxrobot markdown [flags] [content]
+//	wxrobot image [flags] <path|->
+//	wxrobot file [flags] <path>
+//	wxrobot news [flags] --title <title> --url <url>
+//	wxrobot card [flags] <spec.json|spec.yaml|->
+//	tail -f app.log | wxrobot pipe [flags]
+//	wxrobot exec [flags] -- <command> [args]
+//
+// content 为空或为 - 时从标准输入读取；webhook 依次取自 --webhook、环境变量 WXROBOT_WEBHOOK、
+// 配置文件(--config、环境变量 WXROBOT_CONFIG 或用户配置目录下的 wxrobot/config.yaml)。
+// 发送失败或返回的 errcode 不为 0 时退出码为 1，参数错误时为 2，exec 的退出码与命令一致
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	robot "github.com/group-robot/work-weixin-robot"
+)
+
+// 退出码
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// errUsage 参数错误，退出码为 exitUsage
+var errUsage = errors.New("usage")
+
+// builder 由参数生成消息，返回 nil 时子命令已自行发送
+type builder func(ctx context.Context, cli *cli, args []string) (robot.Message, error)
+
+// command 子命令，flags 注册子命令的参数并返回 builder
+type command struct {
+	name    string
+	args    string
+	summary string
+	flags   func(fs *flag.FlagSet) builder
+}
+
+// cli 一次运行的输入输出及客户端
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	client *robot.WorkWeixinRobotClient
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run 执行子命令并返回退出码
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+	cmd := findCommand(args[0])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "wxrobot: unknown command %q\n", args[0])
+		usage(stderr)
+		return exitUsage
+	}
+
+	fs := flag.NewFlagSet("wxrobot "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	webhook := fs.String("webhook", "", "webhook address or key")
+	config := fs.String("config", "", "config file (default $WXROBOT_CONFIG or <user config dir>/wxrobot/config.yaml)")
+	dryRun := fs.Bool("dry-run", false, "print the message instead of sending it")
+	timeout := fs.Duration("timeout", 10*time.Second, "request timeout")
+	build := cmd.flags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: wxrobot %s [flags] %s\n\n%s\n\nflags:\n", cmd.name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	url, err := resolveWebhook(*webhook, *config)
+	if err != nil {
+		fmt.Fprintf(stderr, "wxrobot: %v\n", err)
+		return exitUsage
+	}
+	opts := []robot.ClientOption{robot.WithTimeout(*timeout), robot.WithUserAgent("wxrobot")}
+	if *dryRun {
+		opts = append(opts, robot.WithDryRun(stdout))
+	}
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr, client: robot.NewRobotClientByWebHook(url, opts...)}
+
+	ctx := context.Background()
+	message, err := build(ctx, c, fs.Args())
+	if err == nil && message != nil {
+		err = c.send(ctx, message)
+	}
+	var exitCode *exitCodeError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.As(err, &exitCode):
+		return exitCode.code
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(stderr, "wxrobot %s: %v\n", cmd.name, err)
+		fs.Usage()
+		return exitUsage
+	default:
+		fmt.Fprintf(stderr, "wxrobot %s: %v\n", cmd.name, err)
+		return exitError
+	}
+}
+
+// send 发送消息，errcode 不为 0 时返回错误
+func (c *cli) send(ctx context.Context, message robot.Message) error {
+	res, err := c.client.SendMessageContext(ctx, message)
+	if err != nil {
+		return err
+	}
+	return responseError(res)
+}
+
+// responseError 没有返回结果或 errcode 不为 0 时返回错误
+func responseError(res *robot.RobotResponse) error {
+	if res == nil {
+		return errors.New("empty response")
+	}
+	if res.IsSuccess() {
+		return nil
+	}
+	return fmt.Errorf("errcode %d: %s", res.ErrCode, res.ErrMsg)
+}
+
+func findCommand(name string) *command {
+	for i := range commands {
+		if commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: wxrobot <command> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-9s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `run "wxrobot <command> -h" for the flags of a command`)
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+// runForTest 运行 wxrobot，不读取用户的环境变量及配置文件
+func runForTest(t *testing.T, stdin string, args ...string) (code int, stdout, stderr string) {
+	t.Setenv(webhookEnv, "")
+	t.Setenv(configEnv, writeFile(t, "config.yaml", ""))
+	var out, errOut bytes.Buffer
+	code = run(args, strings.NewReader(stdin), &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRun_Text(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+
+	code, _, stderr := runForTest(t, "disk full\n", "text", "--webhook", server.Webhook(), "--mention", "zhangsan", "--at-all")
+	if code != exitOK {
+		t.Fatalf("code = %d, stderr = %s", code, stderr)
+	}
+	messages := server.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("messages = %v", messages)
+	}
+	text := messages[0]["text"].(map[string]interface{})
+	if text["content"] != "disk full" || !reflect.DeepEqual(text["mentioned_list"], []interface{}{"zhangsan", "@all"}) {
+		t.Errorf("text = %v", text)
+	}
+}
+
+func TestRun_ErrCode(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	server.Enqueue(robottest.Response{ErrCode: robottest.RateLimitErrCode})
+
+	code, _, stderr := runForTest(t, "", "markdown", "--webhook", server.Webhook(), "**deploy** done")
+	if code != exitError || !strings.Contains(stderr, "errcode 45009") {
+		t.Errorf("code = %d, stderr = %s", code, stderr)
+	}
+	server.Enqueue(robottest.Response{StatusCode: http.StatusInternalServerError})
+	code, _, stderr = runForTest(t, "", "text", "--webhook", server.Webhook(), "deploy done")
+	if code != exitError || !strings.Contains(stderr, "500") {
+		t.Errorf("code = %d, stderr = %s", code, stderr)
+	}
+	code, _, stderr = runForTest(t, "", "markdown", "--webhook", server.Webhook(), "")
+	if code != exitUsage || !strings.Contains(stderr, "empty content") {
+		t.Errorf("code = %d, stderr = %s", code, stderr)
+	}
+}
+
+func TestRun_DryRun(t *testing.T) {
+	code, stdout, stderr := runForTest(t, "", "news", "--webhook", "693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa", "--dry-run",
+		"--title", "release", "--url", "https://example.com/release")
+	if code != exitOK {
+		t.Fatalf("code = %d, stderr = %s", code, stderr)
+	}
+	for _, want := range []string{"POST " + webhookPrefix + "693a***", `"title": "release"`, "# release <https://example.com/release>"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("missing %q in\n%s", want, stdout)
+		}
+	}
+}
+
+func TestRun_File(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	path := writeFile(t, "report.txt", "disk usage report")
+
+	code, _, stderr := runForTest(t, "", "file", "--webhook", server.Webhook(), path)
+	if code != exitOK {
+		t.Fatalf("code = %d, stderr = %s", code, stderr)
+	}
+	uploads := server.Uploads()
+	messages := server.Messages()
+	if len(uploads) != 1 || uploads[0].Filename != "report.txt" || len(messages) != 1 {
+		t.Fatalf("uploads = %v, messages = %v", uploads, messages)
+	}
+	if file := messages[0]["file"].(map[string]interface{}); file["media_id"] != uploads[0].MediaId {
+		t.Errorf("file = %v", file)
+	}
+
+	code, _, stderr = runForTest(t, "", "file", "--webhook", server.Webhook(), writeFile(t, "empty.txt", "x"))
+	if code != exitError || !strings.Contains(stderr, "upload: errcode") {
+		t.Errorf("code = %d, stderr = %s", code, stderr)
+	}
+}
+
+func TestRun_Card(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	spec := writeFile(t, "card.yaml", `
+card_type: text_notice
+main_title:
+  title: 部署完成
+  desc: api v1.2.0
+horizontal_content_list:
+  - keyname: 分支
+    value: main
+card_action:
+  type: 1
+  url: https://ci.example.com/build/1
+`)
+	code, _, stderr := runForTest(t, "", "card", "--webhook", server.Webhook(), spec)
+	if code != exitOK {
+		t.Fatalf("code = %d, stderr = %s", code, stderr)
+	}
+	messages := server.Messages()
+	if len(messages) != 1 || messages[0]["msgtype"] != "template_card" {
+		t.Fatalf("messages = %v", messages)
+	}
+	card := messages[0]["template_card"].(map[string]interface{})
+	if card["main_title"].(map[string]interface{})["title"] != "部署完成" || card["card_action"].(map[string]interface{})["type"] != float64(1) {
+		t.Errorf("card = %v", card)
+	}
+
+	code, _, stderr = runForTest(t, `{"card_type":"text_notice"}`, "card", "--webhook", server.Webhook(), "-")
+	if code != exitError || !strings.Contains(stderr, "errcode") {
+		t.Errorf("code = %d, stderr = %s", code, stderr)
+	}
+}
+
+func TestResolveWebhook(t *testing.T) {
+	t.Setenv(webhookEnv, "")
+	t.Setenv(configEnv, "")
+	config := writeFile(t, "config.yaml", "webhook: config-key\n")
+
+	if got, err := resolveWebhook("", config); err != nil || got != webhookPrefix+"config-key" {
+		t.Errorf("config = %s, %v", got, err)
+	}
+	t.Setenv(webhookEnv, "https://example.com/cgi-bin/webhook/send?key=env")
+	if got, err := resolveWebhook("", config); err != nil || got != "https://example.com/cgi-bin/webhook/send?key=env" {
+		t.Errorf("env = %s, %v", got, err)
+	}
+	if got, err := resolveWebhook("flag-key", config); err != nil || got != webhookPrefix+"flag-key" {
+		t.Errorf("flag = %s, %v", got, err)
+	}
+	t.Setenv(webhookEnv, "")
+	if _, err := resolveWebhook("", filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("missing explicit config")
+	}
+}
+
+func TestRun_Usage(t *testing.T) {
+	if code, _, _ := runForTest(t, ""); code != exitUsage {
+		t.Errorf("no command = %d", code)
+	}
+	if code, _, stderr := runForTest(t, "", "voice"); code != exitUsage || !strings.Contains(stderr, `unknown command "voice"`) {
+		t.Errorf("unknown command = %d, %s", code, stderr)
+	}
+	if code, _, stderr := runForTest(t, "", "text", "hello"); code != exitUsage || !strings.Contains(stderr, "no webhook") {
+		t.Errorf("no webhook = %d, %s", code, stderr)
+	}
+}
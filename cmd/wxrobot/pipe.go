@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"github.com/group-robot/work-weixin-robot/internal/batch"
+)
+
+// maxLineBytes 读取的单行最大字节数，超出部分截断
+const maxLineBytes = 1 << 20
+
+func pipeFlags(fs *flag.FlagSet) builder {
+	match := fs.String("match", "", "only forward lines matching the regexp")
+	exclude := fs.String("exclude", "", "drop lines matching the regexp")
+	window := fs.Duration("window", 10*time.Second, "collect lines for at most this long before sending")
+	maxBytes := fs.Int("max-bytes", 0, "max bytes per message (default 2048 for text, 4096 for markdown)")
+	rate := fs.Int("rate", 20, "max messages per minute, the webhook limit is 20")
+	maxBuffer := fs.Int("max-buffer", 1000, "max buffered lines while rate limited, the oldest lines are dropped")
+	markdown := fs.Bool("markdown", false, "send lines as markdown instead of text")
+	return func(ctx context.Context, c *cli, args []string) (robot.Message, error) {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("%w: unexpected arguments %q", errUsage, args)
+		}
+		p := &pipe{window: *window, markdown: *markdown}
+		var err error
+		if p.match, err = compileFlag("match", *match); err != nil {
+			return nil, err
+		}
+		if p.exclude, err = compileFlag("exclude", *exclude); err != nil {
+			return nil, err
+		}
+		limit := robot.TextMaxBytes
+		if p.markdown {
+			limit = robot.MarkdownMaxBytes
+		}
+		if *maxBytes <= 0 || *maxBytes > limit {
+			*maxBytes = limit
+		}
+		p.maxBytes = *maxBytes
+		p.batch = batch.New(*maxBytes, *rate, *maxBuffer, batch.WithHeader(droppedHeader))
+		return nil, p.run(ctx, c)
+	}
+}
+
+func compileFlag(name, expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: --%s: %v", errUsage, name, err)
+	}
+	return re, nil
+}
+
+// pipe 从标准输入读取行，过滤后按窗口及大小分批发送
+type pipe struct {
+	match    *regexp.Regexp
+	exclude  *regexp.Regexp
+	window   time.Duration
+	markdown bool
+	maxBytes int
+	batch    *batch.Batcher
+	// first 待发送的第一行的读取时间
+	first time.Time
+}
+
+// accept 行是否需要转发
+func (p *pipe) accept(line string) bool {
+	if strings.TrimSpace(line) == "" {
+		return false
+	}
+	if p.match != nil && !p.match.MatchString(line) {
+		return false
+	}
+	return p.exclude == nil || !p.exclude.MatchString(line)
+}
+
+// run 读取到 EOF 后发送剩余的行，频率限制内发送不完时等待；发送失败不中断，结束时返回最后一个错误
+func (p *pipe) run(ctx context.Context, c *cli) error {
+	lines := make(chan line)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		reader := bufio.NewReader(c.stdin)
+		for {
+			text, err := readLine(reader)
+			if text != "" && p.accept(text) {
+				lines <- line(batch.TruncateBytes(text, p.maxBytes))
+			}
+			if err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	var sendErr error
+	flush := func(now time.Time) {
+		for _, content := range p.batch.Take(now) {
+			p.first = now
+			if err := c.send(ctx, p.message(content)); err != nil {
+				fmt.Fprintf(c.stderr, "wxrobot pipe: %v\n", err)
+				sendErr = err
+			}
+		}
+	}
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	defer timer.Stop()
+	var deadline time.Time
+	schedule := func(at time.Time) {
+		if !deadline.IsZero() && !at.Before(deadline) {
+			return
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		deadline = at
+		timer.Reset(time.Until(at))
+	}
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				for {
+					flush(time.Now())
+					wait := p.batch.Wait(time.Now())
+					if wait < 0 {
+						break
+					}
+					time.Sleep(wait)
+				}
+				if err := <-readErr; !errors.Is(err, io.EOF) {
+					return err
+				}
+				return sendErr
+			}
+			now := time.Now()
+			if p.batch.Len() == 0 {
+				p.first = now
+			}
+			p.batch.Push(line)
+			if p.batch.Full() {
+				flush(now)
+			}
+			if p.batch.Len() > 0 {
+				at := p.first.Add(p.window)
+				if wait := p.batch.Wait(now); wait > 0 && now.Add(wait).After(at) {
+					at = now.Add(wait)
+				}
+				schedule(at)
+			}
+		case <-timer.C:
+			deadline = time.Time{}
+			now := time.Now()
+			flush(now)
+			if wait := p.batch.Wait(now); wait >= 0 {
+				schedule(now.Add(wait))
+			}
+		}
+	}
+}
+
+// message 消息内容转换为消息
+func (p *pipe) message(content string) robot.Message {
+	if p.markdown {
+		return robot.NewMarkdownMessage(content)
+	}
+	return robot.NewTextMessage(content)
+}
+
+// readLine 读取一行，去掉换行符，超过 maxLineBytes 的部分丢弃
+func readLine(reader *bufio.Reader) (string, error) {
+	var builder strings.Builder
+	for {
+		chunk, isPrefix, err := reader.ReadLine()
+		if builder.Len() < maxLineBytes {
+			builder.Write(chunk)
+		}
+		if err != nil || !isPrefix {
+			return batch.TruncateBytes(builder.String(), maxLineBytes), err
+		}
+	}
+}
+
+// line 待发送的行
+type line string
+
+func (l line) String() string {
+	return string(l)
+}
+
+// droppedHeader 频率限制期间丢弃的行数
+func droppedHeader(dropped int) string {
+	if dropped == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%d lines dropped)", dropped)
+}
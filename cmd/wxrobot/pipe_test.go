@@ -0,0 +1,64 @@
+package main
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestRun_Pipe(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	server.SetRateLimit(20, time.Minute)
+
+	stdin := "INFO start\nERROR disk full\nERROR timeout ignored\n\nDEBUG x\nERROR " + strings.Repeat("很", 800) + "\n"
+	code, _, stderr := runForTest(t, stdin, "pipe", "--webhook", server.Webhook(), "--match", "^ERROR", "--exclude", "ignored", "--window", "1h")
+	if code != exitOK {
+		t.Fatalf("code = %d, stderr = %s", code, stderr)
+	}
+	contents := server.Contents()
+	if len(contents) != 2 || contents[0] != "ERROR disk full" || !strings.HasPrefix(contents[1], "ERROR 很") || len(contents[1]) > 2048 {
+		t.Errorf("contents = %q", contents)
+	}
+	for _, request := range server.Requests() {
+		if request.ErrCode != 0 {
+			t.Errorf("request = %+v", request)
+		}
+	}
+
+	code, _, stderr = runForTest(t, "", "pipe", "--webhook", server.Webhook(), "--match", "(")
+	if code != exitUsage || !strings.Contains(stderr, "--match") {
+		t.Errorf("code = %d, stderr = %s", code, stderr)
+	}
+}
+
+func TestRun_PipeWindow(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	reader, writer := io.Pipe()
+	t.Setenv(webhookEnv, server.Webhook())
+	t.Setenv(configEnv, writeFile(t, "config.yaml", ""))
+	done := make(chan int)
+	go func() {
+		var out, errOut strings.Builder
+		done <- run([]string{"pipe", "--window", "20ms"}, reader, &out, &errOut)
+	}()
+
+	_, _ = writer.Write([]byte("first\nsecond\n"))
+	deadline := time.Now().Add(time.Second)
+	for len(server.Contents()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	_, _ = writer.Write([]byte("third\n"))
+	_ = writer.Close()
+	if code := <-done; code != exitOK {
+		t.Fatalf("code = %d", code)
+	}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"first\nsecond", "third"}) {
+		t.Errorf("contents = %q", contents)
+	}
+}
@@ -0,0 +1,233 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrUnterminatedQuote 命令参数引号未闭合或以反斜杠结尾
+var ErrUnterminatedQuote = errors.New("work_weixin_robot: unterminated quote or escape")
+
+// Command 收到的命令
+type Command struct {
+	// Name 命令名
+	Name string
+	// Args 参数
+	Args []string
+	// Message 回调消息
+	Message *CallbackMessage
+}
+
+// CommandFunc 处理命令，返回的消息作为回复，返回 nil 时不回复
+type CommandFunc func(ctx context.Context, command *Command) (Message, error)
+
+// CommandOption 命令配置项
+type CommandOption func(*commandEntry)
+
+// WithCommandUsage 帮助中显示的参数说明，如 "<service> <env>"
+func WithCommandUsage(usage string) CommandOption {
+	return func(entry *commandEntry) {
+		entry.usage = usage
+	}
+}
+
+// WithCommandUsers 仅允许这些 userid 执行命令，默认所有成员均可执行
+func WithCommandUsers(userIds ...string) CommandOption {
+	return func(entry *commandEntry) {
+		entry.userIds = append(entry.userIds, userIds...)
+	}
+}
+
+// commandEntry 已注册的命令
+type commandEntry struct {
+	name        string
+	description string
+	usage       string
+	userIds     []string
+	handler     CommandFunc
+}
+
+// allowed userId 是否可以执行命令
+func (entry *commandEntry) allowed(userId string) bool {
+	if len(entry.userIds) == 0 {
+		return true
+	}
+	for _, allowed := range entry.userIds {
+		if allowed == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// CommandRouterOption CommandRouter 配置项
+type CommandRouterOption func(*CommandRouter)
+
+// WithCommandErrorHandler 命令执行失败时的回调，错误信息同时回复给发送者
+func WithCommandErrorHandler(handler func(error)) CommandRouterOption {
+	return func(router *CommandRouter) {
+		router.onError = handler
+	}
+}
+
+// CommandRouter 将 "@机器人 deploy api prod" 形式的文本回调分发到已注册的命令，
+// 实现 CallbackReplyHandler，在 CallbackServer 中以被动回复响应
+type CommandRouter struct {
+	sender   Sender
+	commands map[string]*commandEntry
+	onError  func(error)
+}
+
+// NewCommandRouter create CommandRouter, sender 仅在直接调用 HandleCallback 时用于发送回复，内置 help 命令
+func NewCommandRouter(sender Sender, opts ...CommandRouterOption) *CommandRouter {
+	router := &CommandRouter{
+		sender:   sender,
+		commands: map[string]*commandEntry{},
+		onError:  func(error) {},
+	}
+	for _, opt := range opts {
+		opt(router)
+	}
+	router.Register("help", "show available commands", func(ctx context.Context, command *Command) (Message, error) {
+		return router.Help(command.Message.From.UserId), nil
+	})
+	return router
+}
+
+// Register 注册命令，同名命令被替换
+func (router *CommandRouter) Register(name, description string, handler CommandFunc, opts ...CommandOption) *CommandRouter {
+	entry := &commandEntry{name: name, description: description, handler: handler}
+	for _, opt := range opts {
+		opt(entry)
+	}
+	router.commands[name] = entry
+	return router
+}
+
+// Help userId 可执行的命令列表
+func (router *CommandRouter) Help(userId string) *MarkdownMessage {
+	names := make([]string, 0, len(router.commands))
+	for name, entry := range router.commands {
+		if entry.allowed(userId) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var builder strings.Builder
+	builder.WriteString("**commands**")
+	for _, name := range names {
+		entry := router.commands[name]
+		usage := name
+		if entry.usage != "" {
+			usage += " " + entry.usage
+		}
+		builder.WriteString("\n`" + usage + "` " + entry.description)
+	}
+	return NewMarkdownMessage(builder.String())
+}
+
+// ReplyCallback CallbackReplyHandler, 命令的回复作为被动回复返回，忽略非文本消息
+func (router *CommandRouter) ReplyCallback(ctx context.Context, message *CallbackMessage) (Message, error) {
+	if message.MsgType != TextCallbackType || message.Text == nil {
+		return nil, nil
+	}
+	return router.dispatch(ctx, message), nil
+}
+
+// HandleCallback CallbackHandler, 回复通过 client 主动发送到回调消息的 WebhookUrl，忽略非文本消息
+func (router *CommandRouter) HandleCallback(ctx context.Context, message *CallbackMessage) error {
+	reply, _ := router.ReplyCallback(ctx, message)
+	if reply == nil {
+		return nil
+	}
+	res, err := router.sender.Send(ctx, message.WebhookUrl, reply)
+	if err != nil {
+		return err
+	}
+	if !res.IsSuccess() {
+		return fmt.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+	}
+	return nil
+}
+
+// dispatch 解析并执行命令，返回回复消息
+func (router *CommandRouter) dispatch(ctx context.Context, message *CallbackMessage) Message {
+	userId := message.From.UserId
+	args, err := ParseCommandArgs(stripMentions(message.Text.Content))
+	if err != nil {
+		return NewTextMessage(err.Error()).AddUserIds(userId)
+	}
+	if len(args) == 0 {
+		return router.Help(userId)
+	}
+	entry, ok := router.commands[args[0]]
+	if !ok {
+		return NewTextMessage(fmt.Sprintf("unknown command %q, send help for available commands", args[0])).AddUserIds(userId)
+	}
+	if !entry.allowed(userId) {
+		return NewTextMessage(fmt.Sprintf("%s: permission denied", entry.name)).AddUserIds(userId)
+	}
+	reply, err := entry.handler(ctx, &Command{Name: entry.name, Args: args[1:], Message: message})
+	if err != nil {
+		router.onError(fmt.Errorf("command %s: %w", entry.name, err))
+		return NewTextMessage(fmt.Sprintf("%s: %v", entry.name, err)).AddUserIds(userId)
+	}
+	return reply
+}
+
+// stripMentions 去掉开头的 @机器人
+func stripMentions(content string) string {
+	content = strings.TrimSpace(content)
+	for strings.HasPrefix(content, "@") {
+		index := strings.IndexAny(content, " \t\n")
+		if index < 0 {
+			return ""
+		}
+		content = strings.TrimSpace(content[index:])
+	}
+	return content
+}
+
+// ParseCommandArgs 按空白拆分参数，支持单引号、双引号及反斜杠转义
+func ParseCommandArgs(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, ErrUnterminatedQuote
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,97 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestParseCommandArgs(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"deploy api prod", []string{"deploy", "api", "prod"}},
+		{"  echo   'hello world'  ", []string{"echo", "hello world"}},
+		{`echo "say \"hi\"" a\ b`, []string{"echo", `say "hi"`, "a b"}},
+		{`echo '' 'a\b'`, []string{"echo", "", `a\b`}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got, err := ParseCommandArgs(tt.line)
+		if err != nil {
+			t.Errorf("ParseCommandArgs(%q) error: %v", tt.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseCommandArgs(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+	for _, line := range []string{`echo "hello`, `echo a\`} {
+		if _, err := ParseCommandArgs(line); !errors.Is(err, ErrUnterminatedQuote) {
+			t.Errorf("ParseCommandArgs(%q) err = %v", line, err)
+		}
+	}
+}
+
+func newTextCallback(webhook, userId, content string) *CallbackMessage {
+	return &CallbackMessage{
+		WebhookUrl: webhook,
+		From:       CallbackFrom{UserId: userId},
+		MsgType:    TextCallbackType,
+		Text:       &CallbackText{Content: content},
+	}
+}
+
+func TestCommandRouter(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	var failed error
+	router := NewCommandRouter(NewRobotClient(), WithCommandErrorHandler(func(err error) {
+		failed = err
+	}))
+	router.Register("deploy", "deploy a service", func(ctx context.Context, command *Command) (Message, error) {
+		if len(command.Args) != 2 {
+			return nil, errors.New("usage: deploy <service> <env>")
+		}
+		return NewTextMessage("deploying " + strings.Join(command.Args, " to ")), nil
+	}, WithCommandUsage("<service> <env>"), WithCommandUsers("zhangsan"))
+	router.Register("ping", "check the bot", func(ctx context.Context, command *Command) (Message, error) {
+		return NewTextMessage("pong"), nil
+	})
+
+	ctx := context.Background()
+	for _, callback := range []*CallbackMessage{
+		newTextCallback(server.Webhook(), "zhangsan", "@RobotA deploy api prod"),
+		newTextCallback(server.Webhook(), "lisi", "@RobotA deploy api prod"),
+		newTextCallback(server.Webhook(), "zhangsan", "@RobotA deploy api"),
+		newTextCallback(server.Webhook(), "lisi", "@RobotA rollback"),
+		newTextCallback(server.Webhook(), "lisi", "@RobotA help"),
+		{WebhookUrl: server.Webhook(), MsgType: EventCallbackType, Event: &CallbackEvent{EventType: "add_to_chat"}},
+	} {
+		if err := router.HandleCallback(ctx, callback); err != nil {
+			t.Fatal("handle callback error", err)
+		}
+	}
+
+	want := []string{
+		"deploying api to prod",
+		"deploy: permission denied",
+		"deploy: usage: deploy <service> <env>",
+		`unknown command "rollback", send help for available commands`,
+		"**commands**\n`help` show available commands\n`ping` check the bot",
+	}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, want) {
+		t.Errorf("contents = %q, want %q", contents, want)
+	}
+	if failed == nil {
+		t.Error("error handler not called")
+	}
+	if help := router.Help("zhangsan").Content; !strings.Contains(help, "`deploy <service> <env>` deploy a service") {
+		t.Errorf("help = %q", help)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/group-robot/work-weixin-robot/contrib/logrusrobot
+
+go 1.16
+
+require (
+	github.com/group-robot/work-weixin-robot v0.0.0
+	github.com/sirupsen/logrus v1.9.3
+)
+
+replace github.com/group-robot/work-weixin-robot => ../..
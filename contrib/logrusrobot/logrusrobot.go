@@ -0,0 +1,61 @@
+// Package logrusrobot forwards logrus entries to a work weixin robot
+package logrusrobot
+
+import (
+	"sort"
+
+	"github.com/group-robot/work-weixin-robot/robotlog"
+	"github.com/sirupsen/logrus"
+)
+
+// Hook logrus.Hook by robotlog.Forwarder
+type Hook struct {
+	forwarder *robotlog.Forwarder
+}
+
+// NewHook create Hook
+func NewHook(forwarder *robotlog.Forwarder) *Hook {
+	return &Hook{forwarder: forwarder}
+}
+
+// Levels logrus levels enabled by forwarder
+func (hook *Hook) Levels() []logrus.Level {
+	var levels []logrus.Level
+	for _, level := range logrus.AllLevels {
+		if hook.forwarder.Enabled(convertLevel(level)) {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+// Fire forward entry
+func (hook *Hook) Fire(entry *logrus.Entry) error {
+	attrs := make([]robotlog.Attr, 0, len(entry.Data))
+	for key, value := range entry.Data {
+		attrs = append(attrs, robotlog.Attr{Key: key, Value: value})
+	}
+	// logrus.Fields is unordered
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+	hook.forwarder.Forward(robotlog.Record{
+		Time:    entry.Time,
+		Level:   convertLevel(entry.Level),
+		Message: entry.Message,
+		Attrs:   attrs,
+	})
+	return nil
+}
+
+// convertLevel logrus.Level to robotlog.Level
+func convertLevel(level logrus.Level) robotlog.Level {
+	switch level {
+	case logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel:
+		return robotlog.LevelError
+	case logrus.WarnLevel:
+		return robotlog.LevelWarn
+	case logrus.InfoLevel:
+		return robotlog.LevelInfo
+	default:
+		return robotlog.LevelDebug
+	}
+}
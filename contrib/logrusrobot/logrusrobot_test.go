@@ -0,0 +1,47 @@
+package logrusrobot
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"github.com/group-robot/work-weixin-robot/robotlog"
+	"github.com/sirupsen/logrus"
+)
+
+func TestHook(t *testing.T) {
+	var contents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		message := map[string]map[string]string{}
+		_ = json.Unmarshal(body, &message)
+		contents = append(contents, message["markdown"]["content"])
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	forwarder := robotlog.NewForwarder(robot.NewRobotClientByWebHook(server.URL), robotlog.WithLevel(robotlog.LevelWarn))
+	hook := NewHook(forwarder)
+	if levels := hook.Levels(); len(levels) != 4 {
+		t.Errorf("levels = %v", levels)
+	}
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	logger.AddHook(hook)
+	logger.Info("ignored")
+	logger.WithFields(logrus.Fields{"table": "users", "attempt": 2}).Error("db timeout")
+	_ = forwarder.Close()
+
+	if len(contents) != 1 {
+		t.Fatalf("contents = %q", contents)
+	}
+	for _, line := range []string{"ERROR</font>", "db timeout\n> attempt: 2\n> table: users\n"} {
+		if !strings.Contains(contents[0], line) {
+			t.Errorf("missing %q in %q", line, contents[0])
+		}
+	}
+}
@@ -0,0 +1,21 @@
+module github.com/group-robot/work-weixin-robot/contrib/otelrobot
+
+go 1.20
+
+require (
+	github.com/group-robot/work-weixin-robot v0.0.0
+	go.opentelemetry.io/otel v1.24.0
+	go.opentelemetry.io/otel/sdk v1.24.0
+	go.opentelemetry.io/otel/trace v1.24.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/go-resty/resty/v2 v2.7.0 // indirect
+	go.opentelemetry.io/otel/metric v1.24.0 // indirect
+	golang.org/x/net v0.0.0-20211029224645-99673261e6eb // indirect
+	golang.org/x/sys v0.17.0 // indirect
+)
+
+replace github.com/group-robot/work-weixin-robot => ../..
@@ -0,0 +1,72 @@
+// Package otelrobot adapts OpenTelemetry tracing to work_weixin_robot.Tracer
+package otelrobot
+
+import (
+	"context"
+	"fmt"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer robot.Tracer by OpenTelemetry trace.Tracer
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer create Tracer
+func NewTracer(tracer trace.Tracer) *Tracer {
+	return &Tracer{tracer: tracer}
+}
+
+// Start start client span
+func (tracer *Tracer) Start(ctx context.Context, name string) (context.Context, robot.Span) {
+	ctx, span := tracer.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &Span{span: span}
+}
+
+// Span robot.Span by OpenTelemetry trace.Span
+type Span struct {
+	span trace.Span
+}
+
+// SetAttributes set span attributes
+func (span *Span) SetAttributes(attributes ...robot.Attribute) {
+	kvs := make([]attribute.KeyValue, 0, len(attributes))
+	for _, attr := range attributes {
+		kvs = append(kvs, keyValue(attr))
+	}
+	span.span.SetAttributes(kvs...)
+}
+
+// RecordError record error and set error status
+func (span *Span) RecordError(err error) {
+	span.span.RecordError(err)
+	span.span.SetStatus(codes.Error, err.Error())
+}
+
+// End end span
+func (span *Span) End() {
+	span.span.End()
+}
+
+// keyValue robot.Attribute to attribute.KeyValue
+func keyValue(attr robot.Attribute) attribute.KeyValue {
+	key := "work_weixin_robot." + attr.Key
+	switch value := attr.Value.(type) {
+	case string:
+		return attribute.String(key, value)
+	case int:
+		return attribute.Int(key, value)
+	case int64:
+		return attribute.Int64(key, value)
+	case float64:
+		return attribute.Float64(key, value)
+	case bool:
+		return attribute.Bool(key, value)
+	default:
+		return attribute.String(key, fmt.Sprint(value))
+	}
+}
@@ -0,0 +1,60 @@
+package otelrobot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := NewTracer(provider.Tracer("test"))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, span := tracer.Start(ctx, robot.SendSpanName)
+	span.SetAttributes(
+		robot.Attribute{Key: "msgtype", Value: "text"},
+		robot.Attribute{Key: "errcode", Value: 45009},
+		robot.Attribute{Key: "retries", Value: int64(2)},
+	)
+	span.RecordError(errors.New("api freq out of limit"))
+	span.End()
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("spans = %d", len(spans))
+	}
+	got := spans[0]
+	if got.Name() != robot.SendSpanName || got.SpanKind() != trace.SpanKindClient {
+		t.Errorf("unexpected span: %s %s", got.Name(), got.SpanKind())
+	}
+	if got.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("span is not a child of the caller span")
+	}
+	if got.Status().Code != codes.Error {
+		t.Errorf("status = %v", got.Status())
+	}
+	want := map[attribute.Key]attribute.Value{
+		"work_weixin_robot.msgtype": attribute.StringValue("text"),
+		"work_weixin_robot.errcode": attribute.IntValue(45009),
+		"work_weixin_robot.retries": attribute.Int64Value(2),
+	}
+	for _, kv := range got.Attributes() {
+		if value, ok := want[kv.Key]; ok && value != kv.Value {
+			t.Errorf("attribute %s = %v, want %v", kv.Key, kv.Value.Emit(), value.Emit())
+		}
+		delete(want, kv.Key)
+	}
+	if len(want) > 0 {
+		t.Errorf("missing attributes: %v", want)
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/group-robot/work-weixin-robot/contrib/zaprobot
+
+go 1.19
+
+require (
+	github.com/group-robot/work-weixin-robot v0.0.0
+	go.uber.org/zap v1.27.0
+)
+
+require (
+	github.com/go-resty/resty/v2 v2.7.0 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/net v0.0.0-20211029224645-99673261e6eb // indirect
+)
+
+replace github.com/group-robot/work-weixin-robot => ../..
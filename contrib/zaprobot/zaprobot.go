@@ -0,0 +1,84 @@
+// Package zaprobot forwards zap entries to a work weixin robot
+package zaprobot
+
+import (
+	"github.com/group-robot/work-weixin-robot/robotlog"
+	"go.uber.org/zap/zapcore"
+)
+
+// Core zapcore.Core by robotlog.Forwarder
+type Core struct {
+	forwarder *robotlog.Forwarder
+	fields    []zapcore.Field
+}
+
+// NewCore create Core, 可通过 zapcore.NewTee 与已有 Core 组合
+func NewCore(forwarder *robotlog.Forwarder) *Core {
+	return &Core{forwarder: forwarder}
+}
+
+// Enabled zapcore.LevelEnabler
+func (core *Core) Enabled(level zapcore.Level) bool {
+	return core.forwarder.Enabled(convertLevel(level))
+}
+
+// With zapcore.Core
+func (core *Core) With(fields []zapcore.Field) zapcore.Core {
+	return &Core{
+		forwarder: core.forwarder,
+		fields:    append(append([]zapcore.Field{}, core.fields...), fields...),
+	}
+}
+
+// Check zapcore.Core
+func (core *Core) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if core.Enabled(entry.Level) {
+		return checked.AddCore(entry, core)
+	}
+	return checked
+}
+
+// Write zapcore.Core
+func (core *Core) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	encoder := zapcore.NewMapObjectEncoder()
+	var keys []string
+	for _, field := range append(append([]zapcore.Field{}, core.fields...), fields...) {
+		if _, ok := encoder.Fields[field.Key]; !ok {
+			keys = append(keys, field.Key)
+		}
+		field.AddTo(encoder)
+	}
+	attrs := make([]robotlog.Attr, 0, len(keys))
+	for _, key := range keys {
+		if value, ok := encoder.Fields[key]; ok {
+			attrs = append(attrs, robotlog.Attr{Key: key, Value: value})
+		}
+	}
+	core.forwarder.Forward(robotlog.Record{
+		Time:    entry.Time,
+		Level:   convertLevel(entry.Level),
+		Message: entry.Message,
+		Attrs:   attrs,
+	})
+	return nil
+}
+
+// Sync flush forwarder
+func (core *Core) Sync() error {
+	core.forwarder.Flush()
+	return nil
+}
+
+// convertLevel zapcore.Level to robotlog.Level
+func convertLevel(level zapcore.Level) robotlog.Level {
+	switch {
+	case level >= zapcore.ErrorLevel:
+		return robotlog.LevelError
+	case level == zapcore.WarnLevel:
+		return robotlog.LevelWarn
+	case level == zapcore.InfoLevel:
+		return robotlog.LevelInfo
+	default:
+		return robotlog.LevelDebug
+	}
+}
@@ -0,0 +1,41 @@
+package zaprobot
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"github.com/group-robot/work-weixin-robot/robotlog"
+	"go.uber.org/zap"
+)
+
+func TestCore(t *testing.T) {
+	var contents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		message := map[string]map[string]string{}
+		_ = json.Unmarshal(body, &message)
+		contents = append(contents, message["markdown"]["content"])
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	forwarder := robotlog.NewForwarder(robot.NewRobotClientByWebHook(server.URL), robotlog.WithLevel(robotlog.LevelWarn))
+	logger := zap.New(NewCore(forwarder)).With(zap.String("service", "api"))
+	logger.Info("ignored")
+	logger.Error("db timeout", zap.String("table", "users"), zap.Int("attempt", 2))
+	_ = forwarder.Close()
+
+	if len(contents) != 1 {
+		t.Fatalf("contents = %q", contents)
+	}
+	for _, line := range []string{"ERROR</font>", "db timeout\n> service: api\n> table: users\n> attempt: 2\n"} {
+		if !strings.Contains(contents[0], line) {
+			t.Errorf("missing %q in %q", line, contents[0])
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package work_weixin_robot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	from := time.Date(2022, 8, 15, 10, 30, 15, 0, time.UTC) // Monday
+	cases := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2022, 8, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2022, 8, 15, 10, 45, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2022, 8, 16, 9, 30, 0, 0, time.UTC)},
+		{"0 18 * * 5", time.Date(2022, 8, 19, 18, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2022, 8, 21, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2022, 8, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 3", time.Date(2022, 8, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"5-10/5 10 * * *", time.Date(2022, 8, 16, 10, 5, 0, 0, time.UTC)},
+		{"@daily", time.Date(2022, 8, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2022, 8, 15, 11, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		schedule, err := ParseCron(c.spec)
+		if err != nil {
+			t.Errorf("ParseCron(%q) error: %v", c.spec, err)
+			continue
+		}
+		if next := schedule.Next(from); !next.Equal(c.next) {
+			t.Errorf("ParseCron(%q).Next = %s, want %s", c.spec, next, c.next)
+		}
+	}
+}
+
+func TestParseCronLocation(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	schedule, _ := ParseCron("0 9 * * *")
+	next := schedule.Next(time.Date(2022, 8, 15, 2, 0, 0, 0, time.UTC).In(loc))
+	if want := time.Date(2022, 8, 16, 1, 0, 0, 0, time.UTC); !next.Equal(want) {
+		t.Errorf("next = %s, want %s", next, want)
+	}
+}
+
+func TestParseCronError(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if _, err := ParseCron(spec); err == nil {
+			t.Errorf("ParseCron(%q) expected error", spec)
+		}
+	}
+	schedule, _ := ParseCron("0 0 30 2 *")
+	if next := schedule.Next(time.Now()); !next.IsZero() {
+		t.Errorf("next = %s", next)
+	}
+}
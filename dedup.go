@@ -0,0 +1,203 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrDuplicate 窗口内的重复消息已被抑制
+var ErrDuplicate = fmt.Errorf("%w: duplicate message suppressed", ErrDropped)
+
+type dedupKeyContextKey struct{}
+
+// ContextWithDedupKey 指定去重 key，代替消息内容的哈希
+func ContextWithDedupKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, dedupKeyContextKey{}, key)
+}
+
+// DedupOption Deduplicator 配置项
+type DedupOption func(*Deduplicator)
+
+// WithDedupClock 时钟，默认 SystemClock
+func WithDedupClock(clock Clock) DedupOption {
+	return func(dedup *Deduplicator) {
+		dedup.clock = clock
+	}
+}
+
+// WithDedupErrorHandler 汇总消息发送失败时的回调
+func WithDedupErrorHandler(handler func(error)) DedupOption {
+	return func(dedup *Deduplicator) {
+		dedup.onError = handler
+	}
+}
+
+// dedupEntry 窗口内的消息
+type dedupEntry struct {
+	url     string
+	summary string
+	count   int
+	timer   Timer
+	next    Handler
+	// failed 上次发送失败，下一条重复消息照常发送
+	failed bool
+}
+
+// Deduplicator 消息去重，窗口内重复的消息不再发送，窗口结束时发送一条 "repeated N times" 汇总消息
+type Deduplicator struct {
+	window  time.Duration
+	clock   Clock
+	onError func(error)
+
+	mu      sync.Mutex
+	entries map[string]*dedupEntry
+}
+
+// NewDeduplicator create Deduplicator
+func NewDeduplicator(window time.Duration, opts ...DedupOption) *Deduplicator {
+	dedup := &Deduplicator{
+		window:  window,
+		clock:   SystemClock,
+		onError: func(error) {},
+		entries: map[string]*dedupEntry{},
+	}
+	for _, opt := range opts {
+		opt(dedup)
+	}
+	return dedup
+}
+
+// WithDedup 消息去重
+func WithDedup(dedup *Deduplicator) ClientOption {
+	return WithMiddleware(dedup.Middleware)
+}
+
+// Middleware 去重拦截器，重复消息返回 ErrDuplicate
+func (dedup *Deduplicator) Middleware(next Handler) Handler {
+	return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+		key := dedupKey(ctx, request)
+		dedup.mu.Lock()
+		entry, ok := dedup.entries[key]
+		switch {
+		case ok && !entry.failed:
+			entry.count++
+			dedup.mu.Unlock()
+			return nil, ErrDuplicate
+		case ok:
+			entry.failed = false
+		default:
+			entry = &dedupEntry{url: request.Url, summary: summary(request), next: next}
+			entry.timer = dedup.clock.AfterFunc(dedup.window, func() {
+				dedup.expire(key, entry)
+			})
+			dedup.entries[key] = entry
+		}
+		dedup.mu.Unlock()
+		res, err := next(ctx, request)
+		if failed(res, err) {
+			// 发送失败时不抑制下一条重复消息，窗口及已抑制的次数保留，窗口结束时仍发送汇总消息
+			dedup.mu.Lock()
+			entry.failed = true
+			dedup.mu.Unlock()
+		}
+		return res, err
+	}
+}
+
+// expire 窗口结束，发送汇总消息
+func (dedup *Deduplicator) expire(key string, entry *dedupEntry) {
+	dedup.mu.Lock()
+	if dedup.entries[key] != entry {
+		dedup.mu.Unlock()
+		return
+	}
+	delete(dedup.entries, key)
+	dedup.mu.Unlock()
+	dedup.report(entry)
+}
+
+// report 发送汇总消息
+func (dedup *Deduplicator) report(entry *dedupEntry) {
+	if entry.count == 0 {
+		return
+	}
+	times := "times"
+	if entry.count == 1 {
+		times = "time"
+	}
+	content := fmt.Sprintf("repeated %d %s in the last %s: %s", entry.count, times, formatWindow(dedup.window), entry.summary)
+	request, err := newRobotRequest(entry.url, NewTextMessage(content))
+	if err == nil {
+		var res *RobotResponse
+		res, err = entry.next(context.Background(), request)
+		if err == nil && res != nil && !res.IsSuccess() {
+			err = fmt.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+		}
+	}
+	if err != nil && !notSent(err) {
+		dedup.onError(err)
+	}
+}
+
+// Flush 立即结束所有窗口并发送汇总消息
+func (dedup *Deduplicator) Flush() {
+	dedup.mu.Lock()
+	entries := dedup.entries
+	dedup.entries = map[string]*dedupEntry{}
+	dedup.mu.Unlock()
+	for _, entry := range entries {
+		entry.timer.Stop()
+		dedup.report(entry)
+	}
+}
+
+// failed 发送是否失败，汇总、延迟发送或丢弃不是发送失败
+func failed(res *RobotResponse, err error) bool {
+	if notSent(err) {
+		return false
+	}
+	return err != nil || res == nil || !res.IsSuccess()
+}
+
+// dedupKey 去重 key，默认为 webhook 与消息体的哈希
+func dedupKey(ctx context.Context, request *RobotRequest) string {
+	if key, ok := ctx.Value(dedupKeyContextKey{}).(string); ok {
+		return request.Url + "\x00" + key
+	}
+	sum := sha256.Sum256(request.Body)
+	return request.Url + "\x00" + hex.EncodeToString(sum[:])
+}
+
+// summary 消息摘要
+func summary(request *RobotRequest) string {
+	var content string
+	switch message := request.Message.(type) {
+	case *TextMessage:
+		content = message.Content
+	case *MarkdownMessage:
+		content = message.Content
+	default:
+		content = string(request.MsgType()) + " message"
+	}
+	if utf8.RuneCountInString(content) > 100 {
+		content = string([]rune(content)[:100]) + "..."
+	}
+	return content
+}
+
+// formatWindow 格式化时间窗口，如 10m、1h
+func formatWindow(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d >= time.Minute && d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return d.String()
+	}
+}
@@ -0,0 +1,156 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestDeduplicator(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	dedup := NewDeduplicator(10*time.Minute, WithDedupClock(clock))
+	client := NewRobotClientByWebHook(server.Webhook(), WithDedup(dedup))
+
+	for i := 0; i < 4; i++ {
+		_, err := client.SendMessage(NewTextMessage("disk full"))
+		if i == 0 && err != nil {
+			t.Fatal("send message error", err)
+		}
+		if i > 0 && !errors.Is(err, ErrDuplicate) {
+			t.Errorf("send %d: err = %v", i, err)
+		}
+	}
+	if !errors.Is(ErrDuplicate, ErrDropped) {
+		t.Error("ErrDuplicate is not ErrDropped")
+	}
+	if _, err := client.SendMessage(NewTextMessage("cpu high")); err != nil {
+		t.Fatal("send message error", err)
+	}
+	clock.Advance(10 * time.Minute)
+	if _, err := client.SendMessage(NewTextMessage("disk full")); err != nil {
+		t.Fatal("send message error", err)
+	}
+
+	want := []string{"disk full", "cpu high", "repeated 3 times in the last 10m: disk full", "disk full"}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, want) {
+		t.Errorf("contents = %q, want %q", contents, want)
+	}
+}
+
+func TestDeduplicator_Key(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	dedup := NewDeduplicator(time.Hour, WithDedupClock(clock))
+	client := NewRobotClientByWebHook(server.Webhook(), WithDedup(dedup))
+
+	ctx := ContextWithDedupKey(context.Background(), "disk:/dev/sda1")
+	if _, err := client.SendMessageContext(ctx, NewTextMessage("disk 91%")); err != nil {
+		t.Fatal("send message error", err)
+	}
+	if _, err := client.SendMessageContext(ctx, NewMarkdownMessage("disk 95%")); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("err = %v", err)
+	}
+	dedup.Flush()
+
+	want := []string{"disk 91%", "repeated 1 time in the last 1h: disk 91%"}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, want) {
+		t.Errorf("contents = %q, want %q", contents, want)
+	}
+}
+
+func TestDeduplicator_Failed(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	server.Enqueue(robottest.Response{ErrCode: robottest.RateLimitErrCode})
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	dedup := NewDeduplicator(10*time.Minute, WithDedupClock(clock))
+	client := NewRobotClientByWebHook(server.Webhook(), WithDedup(dedup))
+
+	if res, err := client.SendMessage(NewTextMessage("disk full")); err != nil || res.ErrCode != robottest.RateLimitErrCode {
+		t.Fatalf("first = %+v, %v", res, err)
+	}
+	if res, err := client.SendMessage(NewTextMessage("disk full")); err != nil || !res.IsSuccess() {
+		t.Fatalf("repeat = %+v, %v", res, err)
+	}
+	if _, err := client.SendMessage(NewTextMessage("disk full")); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("err = %v", err)
+	}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"disk full"}) {
+		t.Errorf("contents = %q", contents)
+	}
+}
+
+func TestDeduplicator_FailedInFlight(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	server.Enqueue(robottest.Response{ErrCode: robottest.RateLimitErrCode, Latency: 50 * time.Millisecond})
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	dedup := NewDeduplicator(10*time.Minute, WithDedupClock(clock))
+	client := NewRobotClientByWebHook(server.Webhook(), WithDedup(dedup))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.SendMessage(NewTextMessage("disk full"))
+	}()
+	for len(server.Requests()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := client.SendMessage(NewTextMessage("disk full")); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("in flight err = %v", err)
+	}
+	<-done
+	if _, err := client.SendMessage(NewTextMessage("disk full")); err != nil {
+		t.Fatal("send message error", err)
+	}
+	clock.Advance(10 * time.Minute)
+
+	want := []string{"disk full", "repeated 1 time in the last 10m: disk full"}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, want) {
+		t.Errorf("contents = %q, want %q", contents, want)
+	}
+}
+
+func TestDeduplicator_Accepted(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 23, 0, 0, 0, time.UTC))
+	client := NewRobotClientByWebHook(server.Webhook())
+	aggregator := NewAggregator(client, time.Hour, WithAggregatorClock(clock))
+	quiet, _ := NewQuietHours("22:00", "08:00", time.UTC)
+	policy := NewPolicy(client, WithPolicyClock(clock), WithQuietHours("", quiet))
+
+	for _, next := range []Sender{aggregator, policy.Sender(SeverityInfo)} {
+		var errs []error
+		dedup := NewDeduplicator(10*time.Minute, WithDedupClock(clock), WithDedupErrorHandler(func(err error) { errs = append(errs, err) }))
+		sender := WrapSender(next, dedup.Middleware)
+		for i := 0; i < 3; i++ {
+			_, err := sender.Send(context.Background(), "", NewTextMessage("disk full"))
+			want := ErrAccepted
+			if i > 0 {
+				want = ErrDuplicate
+			}
+			if !errors.Is(err, want) {
+				t.Errorf("%T send %d: err = %v", next, i, err)
+			}
+		}
+		dedup.Flush()
+		if len(errs) != 0 {
+			t.Errorf("%T: errs = %v", next, errs)
+		}
+	}
+	if err := aggregator.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"disk full\nrepeated 2 times in the last 10m: disk full"}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, want) {
+		t.Errorf("contents = %q, want %q", contents, want)
+	}
+}
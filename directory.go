@@ -0,0 +1,140 @@
+package work_weixin_robot
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// User 企业微信成员
+type User struct {
+	// UserId 成员 userid
+	UserId string `json:"userid"`
+	// Mobile 手机号
+	Mobile string `json:"mobile"`
+	// Name 姓名
+	Name string `json:"name"`
+	// Aliases 其他身份，如邮箱、GitHub 用户名
+	Aliases []string `json:"aliases"`
+}
+
+// UserDirectory 成员目录，按 userid、手机号或其他身份查找成员
+type UserDirectory interface {
+	// Lookup 查找成员，未找到时返回 false
+	Lookup(identity string) (*User, bool)
+}
+
+// MemoryDirectory 内存成员目录，身份不区分大小写
+type MemoryDirectory struct {
+	users map[string]*User
+}
+
+// NewMemoryDirectory create MemoryDirectory
+func NewMemoryDirectory(users ...*User) *MemoryDirectory {
+	directory := &MemoryDirectory{users: map[string]*User{}}
+	directory.Add(users...)
+	return directory
+}
+
+// Add 添加成员，以 userid、手机号及 Aliases 为身份
+func (directory *MemoryDirectory) Add(users ...*User) *MemoryDirectory {
+	for _, user := range users {
+		for _, identity := range append([]string{user.UserId, user.Mobile}, user.Aliases...) {
+			if identity = strings.TrimSpace(identity); identity != "" {
+				directory.users[strings.ToLower(identity)] = user
+			}
+		}
+	}
+	return directory
+}
+
+// Lookup UserDirectory
+func (directory *MemoryDirectory) Lookup(identity string) (*User, bool) {
+	user, ok := directory.users[strings.ToLower(strings.TrimSpace(identity))]
+	return user, ok
+}
+
+// NewCSVDirectory 从 CSV 创建成员目录，首行为表头，须包含 userid 或 mobile 列，
+// name 列为姓名，其他列(如 email、github)均作为身份
+func NewCSVDirectory(r io.Reader) (*MemoryDirectory, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("csv directory: missing header")
+	}
+	header := records[0]
+	columns := map[string]int{}
+	for i, name := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(name))
+		columns[header[i]] = i
+	}
+	_, hasUserId := columns["userid"]
+	_, hasMobile := columns["mobile"]
+	if !hasUserId && !hasMobile {
+		return nil, errors.New("csv directory: missing userid or mobile column")
+	}
+	directory := NewMemoryDirectory()
+	for line, record := range records[1:] {
+		user := &User{}
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			switch header[i] {
+			case "userid":
+				user.UserId = value
+			case "mobile":
+				user.Mobile = value
+			case "name":
+				user.Name = value
+			default:
+				if value != "" {
+					user.Aliases = append(user.Aliases, value)
+				}
+			}
+		}
+		if user.UserId == "" && user.Mobile == "" {
+			return nil, fmt.Errorf("csv directory: line %d: missing userid and mobile", line+2)
+		}
+		directory.Add(user)
+	}
+	return directory, nil
+}
+
+// NewJSONDirectory 从 JSON 数组创建成员目录，元素格式同 User
+func NewJSONDirectory(r io.Reader) (*MemoryDirectory, error) {
+	var users []*User
+	if err := json.NewDecoder(r).Decode(&users); err != nil {
+		return nil, err
+	}
+	for i, user := range users {
+		if user.UserId == "" && user.Mobile == "" {
+			return nil, fmt.Errorf("json directory: user %d: missing userid and mobile", i)
+		}
+	}
+	return NewMemoryDirectory(users...), nil
+}
+
+// LoadCSVDirectory 从 CSV 文件创建成员目录
+func LoadCSVDirectory(path string) (*MemoryDirectory, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return NewCSVDirectory(file)
+}
+
+// LoadJSONDirectory 从 JSON 文件创建成员目录
+func LoadJSONDirectory(path string) (*MemoryDirectory, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return NewJSONDirectory(file)
+}
@@ -0,0 +1,90 @@
+package work_weixin_robot
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testDirectoryCSV = `userid,mobile,name,email,github
+zhangsan,13800001111,张三,zhangsan@example.com,zs-dev
+,13800002222,李四,lisi@example.com,
+`
+
+const testDirectoryJSON = `[
+  {"userid": "zhangsan", "mobile": "13800001111", "name": "张三", "aliases": ["zhangsan@example.com", "zs-dev"]},
+  {"mobile": "13800002222", "name": "李四", "aliases": ["lisi@example.com"]}
+]`
+
+func testDirectory(t *testing.T, directory UserDirectory) {
+	cases := map[string]string{
+		"zhangsan":             "zhangsan",
+		"13800001111":          "zhangsan",
+		"ZhangSan@Example.com": "zhangsan",
+		"zs-dev":               "zhangsan",
+		"lisi@example.com":     "13800002222",
+	}
+	for identity, want := range cases {
+		user, ok := directory.Lookup(identity)
+		if !ok {
+			t.Errorf("Lookup(%q) not found", identity)
+			continue
+		}
+		if got := user.UserId; got != want && user.Mobile != want {
+			t.Errorf("Lookup(%q) = %+v, want %s", identity, user, want)
+		}
+	}
+	if _, ok := directory.Lookup("wangwu"); ok {
+		t.Error("Lookup(wangwu) found")
+	}
+}
+
+func TestNewCSVDirectory(t *testing.T) {
+	directory, err := NewCSVDirectory(strings.NewReader(testDirectoryCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDirectory(t, directory)
+	if user, _ := directory.Lookup("zs-dev"); user.Name != "张三" {
+		t.Errorf("name = %s", user.Name)
+	}
+	for _, data := range []string{"", "name,email\n张三,a@example.com\n", "userid,mobile\n,\n"} {
+		if _, err := NewCSVDirectory(strings.NewReader(data)); err == nil {
+			t.Errorf("NewCSVDirectory(%q) expected error", data)
+		}
+	}
+}
+
+func TestNewJSONDirectory(t *testing.T) {
+	directory, err := NewJSONDirectory(strings.NewReader(testDirectoryJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDirectory(t, directory)
+	if _, err := NewJSONDirectory(strings.NewReader(`[{"name":"王五"}]`)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestLoadDirectory(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "users.csv")
+	jsonPath := filepath.Join(dir, "users.json")
+	_ = ioutil.WriteFile(csvPath, []byte(testDirectoryCSV), 0o600)
+	_ = ioutil.WriteFile(jsonPath, []byte(testDirectoryJSON), 0o600)
+
+	csvDirectory, err := LoadCSVDirectory(csvPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDirectory(t, csvDirectory)
+	jsonDirectory, err := LoadJSONDirectory(jsonPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDirectory(t, jsonDirectory)
+	if _, err := LoadCSVDirectory(filepath.Join(dir, "missing.csv")); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,68 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/group-robot/work-weixin-robot/robotschema"
+)
+
+// WithDryRun 只校验并输出消息而不发送：向 w 写入请求地址(已脱敏)、缩进的 JSON 消息体及纯文本预览，
+// 校验通过时返回 errcode 为 0 的结果，否则返回企业微信对应的错误码；中间件仍然生效，不进行重试
+func WithDryRun(w io.Writer) ClientOption {
+	return func(options *clientOptions) {
+		options.dryRun = &dryRun{w: w}
+	}
+}
+
+// dryRun 输出消息的 Handler
+type dryRun struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// post Handler, 代替 WorkWeixinRobotClient.post
+func (dry *dryRun) post(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(json.RawMessage(request.Body)); err != nil {
+		// 不是合法的 JSON 时原样输出，同真实发送返回 InvalidParameterErrCode
+		body.Reset()
+		body.Write(request.Body)
+		body.WriteByte('\n')
+	}
+	response := &RobotResponse{ErrCode: 0, ErrMsg: "ok"}
+	if err := robotschema.Validate(request.Body); err != nil {
+		invalid := &robotschema.ValidationError{}
+		if !errors.As(err, &invalid) {
+			return nil, err
+		}
+		response = &RobotResponse{ErrCode: invalid.ErrCode, ErrMsg: invalid.ErrMsg}
+	}
+	message := request.Message
+	if message == nil {
+		message = RawMessage(request.Body)
+	}
+	preview, err := RenderText(message)
+	if err != nil {
+		preview = err.Error() + "\n"
+	}
+
+	dry.mu.Lock()
+	defer dry.mu.Unlock()
+	_, err = fmt.Fprintf(dry.w, "POST %s\n%s--- preview ---\n%s", RedactWebhook(request.Url), body.String(), preview)
+	if err == nil && !response.IsSuccess() {
+		_, err = fmt.Fprintf(dry.w, "--- errcode %d: %s ---\n", response.ErrCode, response.ErrMsg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
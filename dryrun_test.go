@@ -0,0 +1,88 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/group-robot/work-weixin-robot/robotschema"
+)
+
+func TestWithDryRun(t *testing.T) {
+	transport := &countTransport{}
+	var out bytes.Buffer
+	client := NewRobotClientByWebHook(testWebhook, WithTransport(transport), WithDryRun(&out))
+
+	message := NewCardTextNoticeMessage(
+		NewCardMainTitle().SetTitle("部署完成").SetDesc("api v1.2.0"),
+		NewCardAction(ClickUrl).SetUrl("https://ci.example.com/build/1"),
+	).SetSource(
+		NewCardSource().SetDesc("CI"),
+	).SetEmphasisContent(
+		NewCardEmphasisContent().SetTitle("100%").SetDesc("成功率"),
+	).SetQuoteArea(
+		NewCardQuoteArea(ClickUrl).SetUrl("https://ci.example.com/build/1/log").
+			SetTitle("日志").SetQuoteText("build ok\ntests ok"),
+	).SetSubTitle(
+		"耗时 3m20s",
+	).AddHorizontalContents(
+		NewCardHorizontalContent("分支").SetValue("main"),
+	).AddJumps(
+		NewCardJump("查看详情").SetType(ClickUrl).SetUrl("https://ci.example.com/build/1"),
+	)
+	res, err := client.SendMessage(message)
+	if err != nil || !res.IsSuccess() {
+		t.Fatalf("send = %+v, %v", res, err)
+	}
+	if transport.count != 0 {
+		t.Errorf("requests = %d", transport.count)
+	}
+	got := out.String()
+	if strings.Contains(got, "693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa") || !strings.Contains(got, "POST https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=") {
+		t.Errorf("webhook not redacted:\n%s", got)
+	}
+	if !strings.Contains(got, `    "main_title": {`) {
+		t.Errorf("body not indented:\n%s", got)
+	}
+	preview := got[strings.Index(got, "--- preview ---\n")+len("--- preview ---\n"):]
+	want := `[CI]
+# 部署完成
+api v1.2.0
+** 100% ** 成功率
+| 日志
+| build ok
+| tests ok <https://ci.example.com/build/1/log>
+耗时 3m20s
+分支: main
+> 查看详情 <https://ci.example.com/build/1>
+(click) https://ci.example.com/build/1
+`
+	if preview != want {
+		t.Errorf("preview:\n%s\nwant:\n%s", preview, want)
+	}
+}
+
+func TestWithDryRun_Invalid(t *testing.T) {
+	var out bytes.Buffer
+	client := NewRobotClientByWebHook(testWebhook, WithDryRun(&out))
+
+	res, err := client.SendMessageStr(`{"msgtype":"markdown","markdown":{"content":""}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.IsSuccess() || res.ErrCode != 40058 {
+		t.Errorf("res = %+v", res)
+	}
+	if !strings.Contains(out.String(), "--- errcode 40058: ") {
+		t.Errorf("out:\n%s", out.String())
+	}
+
+	out.Reset()
+	res, err = client.SendMessageStr(`{"msgtype":"text",`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ErrCode != robotschema.InvalidParameterErrCode || !strings.Contains(out.String(), `{"msgtype":"text",`) {
+		t.Errorf("res = %+v, out:\n%s", res, out.String())
+	}
+}
@@ -0,0 +1,166 @@
+// Package batch 批量发送的缓存及按字节截断，供 robotlog 与 wxrobot 共用
+package batch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TruncateBytes 按字节截断，保证 utf8 完整
+func TruncateBytes(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	if len(s) <= max {
+		return s
+	}
+	s = s[:max]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// Option Batcher 配置项
+type Option func(*Batcher)
+
+// WithHeader 消息内容的首行，dropped 为上次发送后丢弃的条目数，默认仅在有丢弃时为 "(N dropped)"
+func WithHeader(header func(dropped int) string) Option {
+	return func(batcher *Batcher) {
+		batcher.header = header
+	}
+}
+
+// WithRelease 条目被发送或丢弃时的回调
+func WithRelease(release func(items []fmt.Stringer)) Option {
+	return func(batcher *Batcher) {
+		batcher.release = release
+	}
+}
+
+// Batcher 批量发送的缓存，条目以换行合并为不超过 maxBytes 的消息内容，
+// 一分钟内最多取出 rateLimit 条消息，缓存超过 maxBuffer 条时丢弃最早的条目；不是并发安全的
+type Batcher struct {
+	maxBytes  int
+	rateLimit int
+	maxBuffer int
+	header    func(dropped int) string
+	release   func(items []fmt.Stringer)
+
+	items   []fmt.Stringer
+	sizes   []int
+	bytes   int
+	dropped int
+	sent    []time.Time
+}
+
+// New create Batcher, rateLimit、maxBuffer 非正数时不限制
+func New(maxBytes, rateLimit, maxBuffer int, opts ...Option) *Batcher {
+	batcher := &Batcher{
+		maxBytes:  maxBytes,
+		rateLimit: rateLimit,
+		maxBuffer: maxBuffer,
+		header: func(dropped int) string {
+			if dropped == 0 {
+				return ""
+			}
+			return fmt.Sprintf("(%d dropped)", dropped)
+		},
+		release: func([]fmt.Stringer) {},
+	}
+	for _, opt := range opts {
+		opt(batcher)
+	}
+	return batcher
+}
+
+// Push 添加条目，超出 maxBuffer 时丢弃最早的条目
+func (batcher *Batcher) Push(item fmt.Stringer) {
+	size := len(item.String()) + 1
+	batcher.items = append(batcher.items, item)
+	batcher.sizes = append(batcher.sizes, size)
+	batcher.bytes += size
+	if overflow := len(batcher.items) - batcher.maxBuffer; batcher.maxBuffer > 0 && overflow > 0 {
+		batcher.release(batcher.items[:overflow])
+		batcher.remove(overflow)
+		batcher.dropped += overflow
+	}
+}
+
+// Len 缓存的条目数
+func (batcher *Batcher) Len() int {
+	return len(batcher.items)
+}
+
+// Full 缓存的条目已足够一条消息，条目大小按 Push 时计算
+func (batcher *Batcher) Full() bool {
+	return batcher.bytes >= batcher.maxBytes
+}
+
+// remove 移除最早的 n 个条目
+func (batcher *Batcher) remove(n int) {
+	for _, size := range batcher.sizes[:n] {
+		batcher.bytes -= size
+	}
+	batcher.items = batcher.items[n:]
+	batcher.sizes = batcher.sizes[n:]
+}
+
+// Take 取出频率限制内可发送的消息内容
+func (batcher *Batcher) Take(now time.Time) []string {
+	var contents []string
+	for len(batcher.items) > 0 && batcher.Allow(now) {
+		var builder strings.Builder
+		builder.WriteString(batcher.header(batcher.dropped))
+		// 放不下第一个条目时，丢弃提示单独发送
+		notice := batcher.dropped > 0 && builder.Len() > 0
+		n := 0
+		for _, item := range batcher.items {
+			text := item.String()
+			size := len(text)
+			if builder.Len() > 0 {
+				size++
+			}
+			if (n > 0 || notice) && builder.Len()+size > batcher.maxBytes {
+				break
+			}
+			if builder.Len() > 0 {
+				builder.WriteString("\n")
+			}
+			builder.WriteString(text)
+			n++
+		}
+		batcher.release(batcher.items[:n])
+		batcher.remove(n)
+		batcher.dropped = 0
+		batcher.sent = append(batcher.sent, now)
+		contents = append(contents, TruncateBytes(builder.String(), batcher.maxBytes))
+	}
+	return contents
+}
+
+// Allow 频率限制，一分钟内最多发送 rateLimit 条
+func (batcher *Batcher) Allow(now time.Time) bool {
+	if batcher.rateLimit <= 0 {
+		return true
+	}
+	i := 0
+	for i < len(batcher.sent) && now.Sub(batcher.sent[i]) >= time.Minute {
+		i++
+	}
+	batcher.sent = batcher.sent[i:]
+	return len(batcher.sent) < batcher.rateLimit
+}
+
+// Wait 距下一次可发送的时间，没有缓存的条目时返回 -1
+func (batcher *Batcher) Wait(now time.Time) time.Duration {
+	if len(batcher.items) == 0 {
+		return -1
+	}
+	if batcher.Allow(now) {
+		return 0
+	}
+	return batcher.sent[0].Add(time.Minute).Sub(now)
+}
@@ -0,0 +1,77 @@
+package batch
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testLine string
+
+func (line testLine) String() string {
+	return string(line)
+}
+
+func TestBatcher(t *testing.T) {
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC)
+	var released []string
+	batcher := New(20, 2, 4, WithRelease(func(items []fmt.Stringer) {
+		for _, item := range items {
+			released = append(released, item.String())
+		}
+	}))
+	for _, line := range []string{"line 1", "line 2", "line 3", "line 4", "line 5", "a very long line, truncated"} {
+		batcher.Push(testLine(line))
+	}
+	if batcher.Len() != 4 || !batcher.Full() {
+		t.Fatalf("len = %d", batcher.Len())
+	}
+	got := batcher.Take(now)
+	if want := []string{"(2 dropped)\nline 3", "line 4\nline 5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("take = %q, want %q", got, want)
+	}
+	if wait := batcher.Wait(now.Add(10 * time.Second)); wait != 50*time.Second {
+		t.Errorf("wait = %v", wait)
+	}
+	if got := batcher.Take(now.Add(time.Minute)); !reflect.DeepEqual(got, []string{"a very long line, tr"}) {
+		t.Errorf("take = %q", got)
+	}
+	if batcher.Len() != 0 || batcher.Full() || batcher.Wait(now) != -1 {
+		t.Errorf("len = %d", batcher.Len())
+	}
+	want := []string{"line 1", "line 2", "line 3", "line 4", "line 5", "a very long line, truncated"}
+	if !reflect.DeepEqual(released, want) {
+		t.Errorf("released = %q", released)
+	}
+}
+
+func TestBatcher_Header(t *testing.T) {
+	batcher := New(20, 0, 0, WithHeader(func(dropped int) string { return "**title**" }))
+	batcher.Push(testLine(strings.Repeat("x", 30)))
+	batcher.Push(testLine("y"))
+	got := batcher.Take(time.Now())
+	if want := []string{"**title**\nxxxxxxxxxx", "**title**\ny"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("take = %q, want %q", got, want)
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	tests := []struct {
+		s    string
+		max  int
+		want string
+	}{
+		{"hello", 10, "hello"},
+		{"hello", 3, "hel"},
+		{"你好", 4, "你"},
+		{"你好", 0, ""},
+		{"你好", -1, ""},
+	}
+	for _, tt := range tests {
+		if got := TruncateBytes(tt.s, tt.max); got != tt.want {
+			t.Errorf("TruncateBytes(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+		}
+	}
+}
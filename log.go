@@ -0,0 +1,160 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrDropped 消息被丢弃(如去重、免打扰)，拦截器丢弃消息时返回该错误或包装该错误
+	ErrDropped = errors.New("work_weixin_robot: message dropped")
+	// ErrAccepted 消息已接收，稍后发送(如汇总、延迟到免打扰结束)，不是发送失败，返回该错误或包装该错误
+	ErrAccepted = errors.New("work_weixin_robot: message accepted for later delivery")
+)
+
+// notSent err 为 ErrDropped 或 ErrAccepted，消息未发送但不是发送失败
+func notSent(err error) bool {
+	return errors.Is(err, ErrDropped) || errors.Is(err, ErrAccepted)
+}
+
+// Logger 日志，方法签名与 *slog.Logger 一致，args 为交替的 key/value
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// LogLevel 日志级别
+type LogLevel int
+
+const (
+	// LevelDebug debug
+	LevelDebug LogLevel = iota
+	// LevelInfo info
+	LevelInfo
+	// LevelWarn warn
+	LevelWarn
+	// LevelError error
+	LevelError
+	// LevelOff 不输出
+	LevelOff
+)
+
+// LogLevels 各类日志的级别
+type LogLevels struct {
+	// Request 发送请求，仅 LevelDebug 时输出消息体
+	Request LogLevel
+	// Response 发送成功
+	Response LogLevel
+	// Failure 请求失败或 errcode 不为0
+	Failure LogLevel
+	// Retry 重试
+	Retry LogLevel
+	// Drop 消息被丢弃
+	Drop LogLevel
+	// Accepted 消息已接收，稍后发送
+	Accepted LogLevel
+}
+
+// DefaultLogLevels 默认日志级别
+var DefaultLogLevels = LogLevels{
+	Request:  LevelDebug,
+	Response: LevelInfo,
+	Failure:  LevelError,
+	Retry:    LevelWarn,
+	Drop:     LevelWarn,
+	Accepted: LevelInfo,
+}
+
+// WithLogger 输出请求、响应、重试及丢弃日志，webhook 已脱敏
+func WithLogger(logger Logger) ClientOption {
+	return func(options *clientOptions) {
+		options.log.logger = logger
+		options.middlewares = append(options.middlewares, options.log.middleware)
+	}
+}
+
+// WithLogLevels 日志级别，默认为 DefaultLogLevels
+func WithLogLevels(levels LogLevels) ClientOption {
+	return func(options *clientOptions) {
+		options.log.levels = levels
+	}
+}
+
+// LoggingMiddleware 输出日志的拦截器
+func LoggingMiddleware(logger Logger, levels LogLevels) Middleware {
+	return (&robotLog{logger: logger, levels: levels}).middleware
+}
+
+// robotLog 客户端日志
+type robotLog struct {
+	logger Logger
+	levels LogLevels
+}
+
+func newRobotLog() *robotLog {
+	return &robotLog{levels: DefaultLogLevels}
+}
+
+func (l *robotLog) log(level LogLevel, msg string, args ...interface{}) {
+	if l.logger == nil {
+		return
+	}
+	switch level {
+	case LevelDebug:
+		l.logger.Debug(msg, args...)
+	case LevelInfo:
+		l.logger.Info(msg, args...)
+	case LevelWarn:
+		l.logger.Warn(msg, args...)
+	case LevelError:
+		l.logger.Error(msg, args...)
+	}
+}
+
+func (l *robotLog) middleware(next Handler) Handler {
+	return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+		webhook := RedactWebhook(request.Url)
+		msgType := request.MsgType()
+		args := []interface{}{"webhook", webhook, "msgtype", msgType, "size", len(request.Body)}
+		if l.levels.Request == LevelDebug {
+			args = append(args, "body", RedactWebhook(string(request.Body)))
+		}
+		l.log(l.levels.Request, "work weixin robot request", args...)
+		start := time.Now()
+		res, err := next(ctx, request)
+		latency := time.Since(start)
+		switch {
+		case errors.Is(err, ErrDropped):
+			l.log(l.levels.Drop, "work weixin robot message dropped",
+				"webhook", webhook, "msgtype", msgType, "reason", err.Error())
+		case errors.Is(err, ErrAccepted):
+			l.log(l.levels.Accepted, "work weixin robot message accepted",
+				"webhook", webhook, "msgtype", msgType, "reason", err.Error())
+		case err != nil:
+			l.log(l.levels.Failure, "work weixin robot request failed",
+				"webhook", webhook, "msgtype", msgType, "latency", latency, "retries", request.Retries, "error", err.Error())
+		case res != nil && !res.IsSuccess():
+			l.log(l.levels.Failure, "work weixin robot response error",
+				"webhook", webhook, "msgtype", msgType, "latency", latency, "retries", request.Retries,
+				"errcode", res.ErrCode, "errmsg", res.ErrMsg)
+		default:
+			l.log(l.levels.Response, "work weixin robot response",
+				"webhook", webhook, "msgtype", msgType, "latency", latency, "retries", request.Retries)
+		}
+		return res, err
+	}
+}
+
+// retry 重试日志
+func (l *robotLog) retry(request *RobotRequest, res *RobotResponse, err error) {
+	args := []interface{}{"webhook", RedactWebhook(request.Url), "msgtype", request.MsgType(), "retries", request.Retries}
+	if err != nil {
+		args = append(args, "error", err.Error())
+	} else {
+		args = append(args, "errcode", res.ErrCode, "errmsg", res.ErrMsg)
+	}
+	l.log(l.levels.Retry, "work weixin robot retry", args...)
+}
@@ -0,0 +1,8 @@
+//go:build go1.21
+// +build go1.21
+
+package work_weixin_robot
+
+import "log/slog"
+
+var _ Logger = slog.Default()
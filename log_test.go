@@ -0,0 +1,121 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testLogRecord struct {
+	level string
+	msg   string
+	args  map[string]interface{}
+}
+
+type testLogger struct {
+	mu      sync.Mutex
+	records []testLogRecord
+}
+
+func (logger *testLogger) record(level, msg string, args ...interface{}) {
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	record := testLogRecord{level: level, msg: msg, args: map[string]interface{}{}}
+	for i := 0; i+1 < len(args); i += 2 {
+		record.args[fmt.Sprint(args[i])] = args[i+1]
+	}
+	logger.records = append(logger.records, record)
+}
+
+func (logger *testLogger) Debug(msg string, args ...interface{}) {
+	logger.record("debug", msg, args...)
+}
+func (logger *testLogger) Info(msg string, args ...interface{}) { logger.record("info", msg, args...) }
+func (logger *testLogger) Warn(msg string, args ...interface{}) { logger.record("warn", msg, args...) }
+func (logger *testLogger) Error(msg string, args ...interface{}) {
+	logger.record("error", msg, args...)
+}
+
+func TestWithLogger(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			_, _ = w.Write([]byte(`{"errcode":45009,"errmsg":"api freq out of limit"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	logger := &testLogger{}
+	webhook := server.URL + "?key=693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa"
+	client := NewRobotClientByWebHook(webhook, WithLogger(logger), WithRetry(1, time.Millisecond))
+	if _, err := client.SendMessage(NewTextMessage("see " + webhook)); err != nil {
+		t.Fatal("send message error", err)
+	}
+	if len(logger.records) != 3 {
+		t.Fatalf("records = %+v", logger.records)
+	}
+	for i, level := range []string{"debug", "warn", "info"} {
+		record := logger.records[i]
+		if record.level != level {
+			t.Errorf("record %d level = %s, want %s", i, record.level, level)
+		}
+		if strings.Contains(fmt.Sprint(record.args), "91f6") {
+			t.Errorf("record %d leaks webhook key: %v", i, record.args)
+		}
+	}
+	if _, ok := logger.records[0].args["body"]; !ok {
+		t.Error("debug request log without body")
+	}
+	if logger.records[1].args["errcode"] != RateLimitErrCode || logger.records[2].args["retries"] != 1 {
+		t.Errorf("unexpected records: %+v", logger.records)
+	}
+}
+
+func TestWithLogLevels(t *testing.T) {
+	drop := func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			return nil, fmt.Errorf("duplicate: %w", ErrDropped)
+		}
+	}
+	logger := &testLogger{}
+	levels := DefaultLogLevels
+	levels.Request = LevelInfo
+	levels.Drop = LevelError
+	client := NewRobotClientByWebHook("http://127.0.0.1:0", WithLogger(logger), WithMiddleware(drop), WithLogLevels(levels))
+	if _, err := client.SendMessage(NewTextMessage("drop")); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(logger.records) != 2 {
+		t.Fatalf("records = %+v", logger.records)
+	}
+	if record := logger.records[0]; record.level != "info" || record.args["body"] != nil {
+		t.Errorf("request record = %+v", record)
+	}
+	if record := logger.records[1]; record.level != "error" || record.msg != "work weixin robot message dropped" {
+		t.Errorf("drop record = %+v", record)
+	}
+}
+
+func TestWithLogger_NilResponse(t *testing.T) {
+	skip := func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			return nil, nil
+		}
+	}
+	logger := &testLogger{}
+	client := NewRobotClientByWebHook("http://127.0.0.1:0", WithLogger(logger), WithMiddleware(skip))
+	if res, err := client.SendMessage(NewTextMessage("skip")); res != nil || err != nil {
+		t.Fatalf("res = %+v, err = %v", res, err)
+	}
+	if len(logger.records) != 2 || logger.records[1].msg != "work weixin robot response" {
+		t.Errorf("records = %+v", logger.records)
+	}
+}
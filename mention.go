@@ -0,0 +1,104 @@
+package work_weixin_robot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MentionAllUserId @所有人
+const MentionAllUserId = "@all"
+
+// UnknownUserError 成员目录中不存在的身份
+type UnknownUserError struct {
+	Identities []string
+}
+
+func (e *UnknownUserError) Error() string {
+	return "unknown users: " + strings.Join(e.Identities, ", ")
+}
+
+// Mentioner 通过成员目录将邮箱、GitHub 用户名等身份解析为 userid 或手机号
+type Mentioner struct {
+	directory UserDirectory
+}
+
+// NewMentioner create Mentioner
+func NewMentioner(directory UserDirectory) *Mentioner {
+	return &Mentioner{directory: directory}
+}
+
+// Resolve 解析身份，优先使用 userid，无 userid 时使用手机号；存在未知身份时返回 *UnknownUserError 及已解析的结果
+func (mentioner *Mentioner) Resolve(identities ...string) (userIds []string, mobiles []string, err error) {
+	var unknown []string
+	for _, identity := range identities {
+		if identity == MentionAllUserId {
+			userIds = appendUnique(userIds, MentionAllUserId)
+			continue
+		}
+		user, ok := mentioner.directory.Lookup(identity)
+		switch {
+		case !ok:
+			unknown = append(unknown, identity)
+		case user.UserId != "":
+			userIds = appendUnique(userIds, user.UserId)
+		default:
+			mobiles = appendUnique(mobiles, user.Mobile)
+		}
+	}
+	if len(unknown) > 0 {
+		err = &UnknownUserError{Identities: unknown}
+	}
+	return userIds, mobiles, err
+}
+
+// MentionText 解析身份并添加到 TextMessage.UserIds、TextMessage.Mobiles，已解析的身份即使出错也会添加
+func (mentioner *Mentioner) MentionText(message *TextMessage, identities ...string) error {
+	userIds, mobiles, err := mentioner.Resolve(identities...)
+	message.UserIds = appendUnique(message.UserIds, userIds...)
+	message.Mobiles = appendUnique(message.Mobiles, mobiles...)
+	return err
+}
+
+// MentionMarkdown 解析身份并添加到 MarkdownMessage.Mentions，markdown 无法通过手机号提醒，仅有手机号的成员视为未知身份
+func (mentioner *Mentioner) MentionMarkdown(message *MarkdownMessage, identities ...string) error {
+	var unknown []string
+	for _, identity := range identities {
+		userIds, _, err := mentioner.Resolve(identity)
+		if err != nil || len(userIds) == 0 {
+			unknown = append(unknown, identity)
+			continue
+		}
+		message.AddMention(userIds...)
+	}
+	if len(unknown) > 0 {
+		return &UnknownUserError{Identities: unknown}
+	}
+	return nil
+}
+
+// ValidateCard 校验模版卡片中 AtHorizontalType 类型的 CardHorizontalContent.UserId 是否为已知成员的 userid
+func (mentioner *Mentioner) ValidateCard(message CardBaseMessage) error {
+	var contents []*CardHorizontalContent
+	switch card := message.(type) {
+	case *CardTextNoticeMessage:
+		contents = card.HorizontalContents
+	case *CardNewsNoticeMessage:
+		contents = card.HorizontalContents
+	}
+	for _, content := range contents {
+		if content.HorizontalType != AtHorizontalType {
+			continue
+		}
+		user, ok := mentioner.directory.Lookup(content.UserId)
+		if !ok {
+			return fmt.Errorf("horizontal content %q: %w", content.KeyName, &UnknownUserError{Identities: []string{content.UserId}})
+		}
+		if user.UserId == "" {
+			return fmt.Errorf("horizontal content %q: user %q has no userid", content.KeyName, content.UserId)
+		}
+		if user.UserId != content.UserId {
+			return fmt.Errorf("horizontal content %q: %q is not a userid, use %q", content.KeyName, content.UserId, user.UserId)
+		}
+	}
+	return nil
+}
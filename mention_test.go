@@ -0,0 +1,68 @@
+package work_weixin_robot
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func newTestMentioner() *Mentioner {
+	return NewMentioner(NewMemoryDirectory(
+		&User{UserId: "zhangsan", Mobile: "13800001111", Aliases: []string{"zhangsan@example.com", "zs-dev"}},
+		&User{Mobile: "13800002222", Aliases: []string{"lisi@example.com"}},
+	))
+}
+
+func TestMentioner_MentionText(t *testing.T) {
+	mentioner := newTestMentioner()
+	message := NewTextMessage("deploy failed").SetUserIds("wangwu")
+	err := mentioner.MentionText(message, "zs-dev", "zhangsan@example.com", "lisi@example.com", "@all", "ghost")
+	var unknown *UnknownUserError
+	if !errors.As(err, &unknown) || !reflect.DeepEqual(unknown.Identities, []string{"ghost"}) {
+		t.Errorf("err = %v", err)
+	}
+	if !reflect.DeepEqual(message.UserIds, []string{"wangwu", "zhangsan", "@all"}) {
+		t.Errorf("userIds = %v", message.UserIds)
+	}
+	if !reflect.DeepEqual(message.Mobiles, []string{"13800002222"}) {
+		t.Errorf("mobiles = %v", message.Mobiles)
+	}
+}
+
+func TestMentioner_ValidateCard(t *testing.T) {
+	mentioner := newTestMentioner()
+	card := func(userId string) *CardTextNoticeMessage {
+		return NewCardTextNoticeMessage(
+			NewCardMainTitle().SetTitle("审批"),
+			NewCardAction(ClickUrl).SetUrl("https://work.weixin.qq.com/?from=openApi"),
+		).AddHorizontalContents(
+			NewCardHorizontalContent("申请人").SetValue("张三"),
+			NewCardHorizontalContent("审批人").SetType(AtHorizontalType).SetUserId(userId),
+		)
+	}
+	if err := mentioner.ValidateCard(card("zhangsan")); err != nil {
+		t.Errorf("err = %v", err)
+	}
+	var unknown *UnknownUserError
+	if err := mentioner.ValidateCard(card("ghost")); !errors.As(err, &unknown) {
+		t.Errorf("err = %v", err)
+	}
+	for _, userId := range []string{"zs-dev", "lisi@example.com"} {
+		if err := mentioner.ValidateCard(card(userId)); err == nil {
+			t.Errorf("ValidateCard(%s) expected error", userId)
+		}
+	}
+}
+
+func TestMentioner_MentionMarkdown(t *testing.T) {
+	mentioner := newTestMentioner()
+	message := NewMarkdownMessage("deploy failed")
+	err := mentioner.MentionMarkdown(message, "zs-dev", "zhangsan", "lisi@example.com", "@all")
+	var unknown *UnknownUserError
+	if !errors.As(err, &unknown) || !reflect.DeepEqual(unknown.Identities, []string{"lisi@example.com"}) {
+		t.Errorf("err = %v", err)
+	}
+	if !reflect.DeepEqual(message.Mentions, []string{"zhangsan", "@all"}) {
+		t.Errorf("mentions = %v", message.Mentions)
+	}
+}
@@ -0,0 +1,216 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestErrCode 请求失败(未收到企业微信响应)时记录的 errcode
+const RequestErrCode = -2
+
+// MetricsRecorder 发送消息指标，webhook 均已脱敏
+type MetricsRecorder interface {
+	// ObserveSend 记录一次发送的结果及耗时
+	ObserveSend(webhook string, msgType MsgType, errCode int, latency time.Duration)
+	// AddRetries 记录重试次数
+	AddRetries(webhook string, msgType MsgType, retries int)
+	// SetQueueDepth 记录待发送消息数量
+	SetQueueDepth(webhook string, depth int)
+}
+
+// MetricsMiddleware 记录发送指标的拦截器
+func MetricsMiddleware(recorder MetricsRecorder) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			start := time.Now()
+			res, err := next(ctx, request)
+			if notSent(err) {
+				// 未发送的消息不计入发送结果
+				return res, err
+			}
+			webhook := RedactWebhook(request.Url)
+			msgType := request.MsgType()
+			errCode := RequestErrCode
+			if err == nil && res != nil {
+				errCode = res.ErrCode
+			}
+			recorder.ObserveSend(webhook, msgType, errCode, time.Since(start))
+			if request.Retries > 0 {
+				recorder.AddRetries(webhook, msgType, request.Retries)
+			}
+			return res, err
+		}
+	}
+}
+
+// WithMetrics 记录发送指标
+func WithMetrics(recorder MetricsRecorder) ClientOption {
+	return WithMiddleware(MetricsMiddleware(recorder))
+}
+
+// DefaultLatencyBuckets 默认耗时分布(秒)
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics MetricsRecorder, 以 Prometheus 文本格式导出
+type PrometheusMetrics struct {
+	namespace string
+	buckets   []float64
+
+	mu        sync.Mutex
+	sent      map[string]float64
+	latencies map[string]*histogram
+	retries   map[string]float64
+	queue     map[string]float64
+}
+
+// histogram 耗时分布
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusMetrics create PrometheusMetrics, 未指定 buckets 时使用 DefaultLatencyBuckets
+func NewPrometheusMetrics(namespace string, buckets ...float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+	return &PrometheusMetrics{
+		namespace: namespace,
+		buckets:   sorted,
+		sent:      map[string]float64{},
+		latencies: map[string]*histogram{},
+		retries:   map[string]float64{},
+		queue:     map[string]float64{},
+	}
+}
+
+func (metrics *PrometheusMetrics) ObserveSend(webhook string, msgType MsgType, errCode int, latency time.Duration) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	metrics.sent[labels("webhook", webhook, "msgtype", string(msgType), "errcode", strconv.Itoa(errCode))]++
+	key := labels("webhook", webhook, "msgtype", string(msgType))
+	h, ok := metrics.latencies[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(metrics.buckets))}
+		metrics.latencies[key] = h
+	}
+	seconds := latency.Seconds()
+	for i, bucket := range metrics.buckets {
+		if seconds <= bucket {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+func (metrics *PrometheusMetrics) AddRetries(webhook string, msgType MsgType, retries int) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	metrics.retries[labels("webhook", webhook, "msgtype", string(msgType))] += float64(retries)
+}
+
+func (metrics *PrometheusMetrics) SetQueueDepth(webhook string, depth int) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	metrics.queue[labels("webhook", webhook)] = float64(depth)
+}
+
+// WriteTo 以 Prometheus 文本格式输出指标
+func (metrics *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	buf := &bytes.Buffer{}
+	metrics.writeSamples(buf, "messages_sent_total", "counter", "Number of messages sent.", metrics.sent)
+	metrics.writeHistogram(buf)
+	metrics.writeSamples(buf, "retries_total", "counter", "Number of send retries.", metrics.retries)
+	metrics.writeSamples(buf, "queue_depth", "gauge", "Number of messages waiting to be sent.", metrics.queue)
+	return buf.WriteTo(w)
+}
+
+// ServeHTTP http.Handler, 用于 Prometheus 抓取
+func (metrics *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = metrics.WriteTo(w)
+}
+
+func (metrics *PrometheusMetrics) name(name string) string {
+	if metrics.namespace == "" {
+		return name
+	}
+	return metrics.namespace + "_" + name
+}
+
+func (metrics *PrometheusMetrics) writeSamples(buf *bytes.Buffer, name, metricType, help string, samples map[string]float64) {
+	if len(samples) == 0 {
+		return
+	}
+	name = metrics.name(name)
+	fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+	for _, key := range sortedKeys(samples) {
+		fmt.Fprintf(buf, "%s{%s} %s\n", name, key, formatFloat(samples[key]))
+	}
+}
+
+func (metrics *PrometheusMetrics) writeHistogram(buf *bytes.Buffer) {
+	if len(metrics.latencies) == 0 {
+		return
+	}
+	name := metrics.name("send_duration_seconds")
+	fmt.Fprintf(buf, "# HELP %s Latency of sending messages.\n# TYPE %s histogram\n", name, name)
+	keys := make([]string, 0, len(metrics.latencies))
+	for key := range metrics.latencies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		h := metrics.latencies[key]
+		for i, bucket := range metrics.buckets {
+			fmt.Fprintf(buf, "%s_bucket{%s,le=\"%s\"} %d\n", name, key, formatFloat(bucket), h.counts[i])
+		}
+		fmt.Fprintf(buf, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, key, h.count)
+		fmt.Fprintf(buf, "%s_sum{%s} %s\n", name, key, formatFloat(h.sum))
+		fmt.Fprintf(buf, "%s_count{%s} %d\n", name, key, h.count)
+	}
+}
+
+// labels 格式化 Prometheus 标签
+func labels(pairs ...string) string {
+	var builder strings.Builder
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteString(pairs[i])
+		builder.WriteString(`="`)
+		builder.WriteString(labelEscaper.Replace(pairs[i+1]))
+		builder.WriteByte('"')
+	}
+	return builder.String()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func sortedKeys(samples map[string]float64) []string {
+	keys := make([]string, 0, len(samples))
+	for key := range samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,71 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrometheusMetrics_WriteTo(t *testing.T) {
+	metrics := NewPrometheusMetrics("wxrobot", 0.1, 1)
+	metrics.ObserveSend("https://example.com/send?key=abcd***", TextMsgType, 0, 50*time.Millisecond)
+	metrics.ObserveSend("https://example.com/send?key=abcd***", TextMsgType, 0, 500*time.Millisecond)
+	metrics.ObserveSend("https://example.com/send?key=abcd***", TextMsgType, 45009, 2*time.Second)
+	metrics.AddRetries("https://example.com/send?key=abcd***", TextMsgType, 2)
+	metrics.SetQueueDepth("https://example.com/send?key=abcd***", 3)
+
+	buf := &bytes.Buffer{}
+	if _, err := metrics.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	labels := `webhook="https://example.com/send?key=abcd***",msgtype="text"`
+	for _, line := range []string{
+		"# TYPE wxrobot_messages_sent_total counter",
+		`wxrobot_messages_sent_total{` + labels + `,errcode="0"} 2`,
+		`wxrobot_messages_sent_total{` + labels + `,errcode="45009"} 1`,
+		"# TYPE wxrobot_send_duration_seconds histogram",
+		`wxrobot_send_duration_seconds_bucket{` + labels + `,le="0.1"} 1`,
+		`wxrobot_send_duration_seconds_bucket{` + labels + `,le="1"} 2`,
+		`wxrobot_send_duration_seconds_bucket{` + labels + `,le="+Inf"} 3`,
+		`wxrobot_send_duration_seconds_sum{` + labels + `} 2.55`,
+		`wxrobot_send_duration_seconds_count{` + labels + `} 3`,
+		`wxrobot_retries_total{` + labels + `} 2`,
+		`wxrobot_queue_depth{webhook="https://example.com/send?key=abcd***"} 3`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("missing line %q in:\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestWithMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errcode":93000,"errmsg":"invalid webhook url"}`))
+	}))
+	defer server.Close()
+
+	metrics := NewPrometheusMetrics("")
+	client := NewRobotClientByWebHook(server.URL+"?key=693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa", WithMetrics(metrics))
+	if _, err := client.SendMessage(NewMarkdownMessage("metrics")); err != nil {
+		t.Fatal("send message error", err)
+	}
+	_, _ = NewRobotClientByWebHook("http://127.0.0.1:0", WithMetrics(metrics)).SendMessage(NewTextMessage("metrics"))
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := recorder.Body.String()
+	if strings.Contains(body, "91f6") {
+		t.Errorf("metrics leak webhook key:\n%s", body)
+	}
+	for _, line := range []string{
+		`messages_sent_total{webhook="` + server.URL + `?key=693a***",msgtype="markdown",errcode="93000"} 1`,
+		`messages_sent_total{webhook="http://127.0.0.1:0",msgtype="text",errcode="-2"} 1`,
+	} {
+		if !strings.Contains(body, line) {
+			t.Errorf("missing line %q in:\n%s", line, body)
+		}
+	}
+}
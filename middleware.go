@@ -0,0 +1,77 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// RobotRequest 机器人发送请求
+type RobotRequest struct {
+	// Url webhook address
+	Url string
+	// Message 消息，通过 SendMessageStr 发送时为 nil
+	Message Message
+	// Body 序列化后的消息体，即实际发送的内容
+	Body []byte
+	// Retries 已重试次数
+	Retries int
+}
+
+// newRobotRequest create RobotRequest
+func newRobotRequest(url string, message Message) (*RobotRequest, error) {
+	request := &RobotRequest{Url: url}
+	if err := request.SetMessage(message); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// SetMessage set RobotRequest.Message, 并重新序列化 RobotRequest.Body
+func (request *RobotRequest) SetMessage(message Message) error {
+	body, err := json.Marshal(message.ToMessageMap())
+	if err != nil {
+		return err
+	}
+	request.Message = message
+	request.Body = body
+	return nil
+}
+
+// MsgType 消息类型，优先取 RobotRequest.Message，否则从 RobotRequest.Body 中解析
+func (request *RobotRequest) MsgType() MsgType {
+	if message, ok := request.Message.(BaseMessage); ok {
+		return message.GetMsgType()
+	}
+	body := struct {
+		MsgType MsgType `json:"msgtype"`
+	}{}
+	_ = json.Unmarshal(request.Body, &body)
+	return body.MsgType
+}
+
+// Handler 处理 RobotRequest 并返回 RobotResponse
+type Handler func(ctx context.Context, request *RobotRequest) (*RobotResponse, error)
+
+// Middleware 发送拦截器，可修改 RobotRequest、直接返回(不调用 next)或观察返回结果
+type Middleware func(next Handler) Handler
+
+// WithMiddleware 添加拦截器，先添加的拦截器位于外层
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(options *clientOptions) {
+		options.middlewares = append(options.middlewares, middlewares...)
+	}
+}
+
+// Use add WorkWeixinRobotClient middlewares, 需在发送消息前调用
+func (client *WorkWeixinRobotClient) Use(middlewares ...Middleware) *WorkWeixinRobotClient {
+	client.middlewares = append(client.middlewares, middlewares...)
+	return client
+}
+
+// chain 组装拦截器
+func chain(handler Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
@@ -0,0 +1,82 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestWorkWeixinRobotClient_Use(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+				order = append(order, name+":before")
+				res, err := next(ctx, request)
+				order = append(order, name+":after")
+				return res, err
+			}
+		}
+	}
+	mutate := func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			if request.MsgType() != TextMsgType {
+				t.Errorf("msgtype = %s", request.MsgType())
+			}
+			message := request.Message.(*TextMessage)
+			if err := request.SetMessage(NewTextMessage("[prod] " + message.Content)); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+	client := NewRobotClientByWebHook(server.URL, WithMiddleware(trace("outer"))).Use(trace("inner"), mutate)
+	res, err := client.SendMessage(NewTextMessage("hello"))
+	if err != nil {
+		t.Fatal("send message error", err)
+	}
+	if !res.IsSuccess() {
+		t.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+	}
+	if want := []string{"outer:before", "inner:before", "inner:after", "outer:after"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+	if content := received["text"].(map[string]interface{})["content"]; content != "[prod] hello" {
+		t.Errorf("content = %v", content)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	block := func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			return &RobotResponse{ErrCode: 0, ErrMsg: "blocked"}, nil
+		}
+	}
+	client := NewRobotClientByWebHook("http://127.0.0.1:0", WithMiddleware(block))
+	res, err := client.SendMessageStr(`{"msgtype":"markdown","markdown":{"content":"hi"}}`)
+	if err != nil {
+		t.Fatal("send message error", err)
+	}
+	if res.ErrMsg != "blocked" {
+		t.Errorf("unexpected response: %+v", res)
+	}
+}
+
+func TestRobotRequest_MsgType(t *testing.T) {
+	request := &RobotRequest{Body: []byte(`{"msgtype":"markdown","markdown":{"content":"hi"}}`)}
+	if request.MsgType() != MarkdownMsgType {
+		t.Errorf("msgtype = %s", request.MsgType())
+	}
+}
@@ -0,0 +1,145 @@
+package work_weixin_robot
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ClientOption WorkWeixinRobotClient 配置项
+type ClientOption func(*clientOptions)
+
+// clientOptions WorkWeixinRobotClient 配置
+type clientOptions struct {
+	httpClient *http.Client
+	transport  http.RoundTripper
+	timeout    time.Duration
+	proxy      string
+	tlsConfig  *tls.Config
+	userAgent  string
+
+	middlewares []Middleware
+	retry       retryOptions
+	log         *robotLog
+	dryRun      *dryRun
+}
+
+// WithHTTPClient 使用自定义的 http.Client
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(options *clientOptions) {
+		options.httpClient = httpClient
+	}
+}
+
+// WithTransport 使用自定义的 http.RoundTripper
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(options *clientOptions) {
+		options.transport = transport
+	}
+}
+
+// WithTimeout 请求超时时间
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithProxy 代理地址，如: http://proxy.example.com:8080，地址无效或 transport 不是 *http.Transport 时请求返回错误
+func WithProxy(proxyURL string) ClientOption {
+	return func(options *clientOptions) {
+		options.proxy = proxyURL
+	}
+}
+
+// WithTLSConfig TLS 配置，transport 不是 *http.Transport 时请求返回错误
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	return func(options *clientOptions) {
+		options.tlsConfig = tlsConfig
+	}
+}
+
+// WithUserAgent 请求头 User-Agent
+func WithUserAgent(userAgent string) ClientOption {
+	return func(options *clientOptions) {
+		options.userAgent = userAgent
+	}
+}
+
+// newClientOptions apply ClientOption
+func newClientOptions(opts ...ClientOption) *clientOptions {
+	options := &clientOptions{log: newRobotLog()}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// restyClient create resty.Client by clientOptions, 不修改调用方的 http.Client 及 http.Transport，
+// 配置无效时每个请求都返回错误，避免绕过代理直接发送
+func (options *clientOptions) restyClient() *resty.Client {
+	var client *resty.Client
+	if options.httpClient != nil {
+		httpClient := *options.httpClient
+		client = resty.NewWithClient(&httpClient)
+	} else {
+		client = resty.New()
+	}
+	client.SetLogger(newRedactLogger(nil))
+	transport, err := options.roundTripper(client.GetClient().Transport)
+	if err != nil {
+		transport = errorTransport{err: err}
+	}
+	client.SetTransport(transport)
+	if options.timeout > 0 {
+		client.SetTimeout(options.timeout)
+	}
+	if options.userAgent != "" {
+		client.SetHeader("User-Agent", options.userAgent)
+	}
+	return client
+}
+
+// roundTripper 在 http.Transport 的副本上设置代理及 TLS 配置，非 *http.Transport 时无法设置，返回错误
+func (options *clientOptions) roundTripper(base http.RoundTripper) (http.RoundTripper, error) {
+	transport := base
+	if options.transport != nil {
+		transport = options.transport
+	}
+	if options.proxy == "" && options.tlsConfig == nil {
+		return transport, nil
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("work_weixin_robot: proxy and TLS config require *http.Transport, got %T", transport)
+	}
+	httpTransport = httpTransport.Clone()
+	if options.proxy != "" {
+		proxyURL, err := url.Parse(options.proxy)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, errors.New("work_weixin_robot: invalid proxy url")
+		}
+		httpTransport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if options.tlsConfig != nil {
+		httpTransport.TLSClientConfig = options.tlsConfig.Clone()
+	}
+	return httpTransport, nil
+}
+
+// errorTransport 每个请求都返回 err
+type errorTransport struct {
+	err error
+}
+
+func (transport errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	return nil, transport.err
+}
@@ -0,0 +1,144 @@
+package work_weixin_robot
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type countTransport struct {
+	count int
+}
+
+func (transport *countTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport.count++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newOptionTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWithUserAgent(t *testing.T) {
+	var userAgent string
+	server := newOptionTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	})
+	client := NewRobotClientByWebHook(server.URL, WithUserAgent("wxrobot-test"))
+	res, err := client.SendMessage(NewTextMessage("user agent"))
+	if err != nil {
+		t.Fatal("send message error", err)
+	}
+	if !res.IsSuccess() {
+		t.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+	}
+	if userAgent != "wxrobot-test" {
+		t.Errorf("User-Agent = %q", userAgent)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	server := newOptionTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	})
+	client := NewRobotClientByWebHook(server.URL, WithTimeout(20*time.Millisecond))
+	if _, err := client.SendMessage(NewTextMessage("timeout")); err == nil {
+		t.Error("expected timeout error")
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	server := newOptionTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	})
+	transport := &countTransport{}
+	client := NewRobotClientByWebHook(server.URL, WithTransport(transport))
+	if _, err := client.SendMessage(NewTextMessage("transport")); err != nil {
+		t.Fatal("send message error", err)
+	}
+	if transport.count != 1 {
+		t.Errorf("transport count = %d", transport.count)
+	}
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	server := newOptionTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errcode":93000,"errmsg":"invalid webhook url"}`))
+	})
+	transport := &countTransport{}
+	client := NewRobotClientByWebHook(server.URL, WithHTTPClient(&http.Client{Transport: transport}))
+	res, err := client.SendMessage(NewTextMessage("http client"))
+	if err != nil {
+		t.Fatal("send message error", err)
+	}
+	if res.IsSuccess() || res.ErrCode != 93000 {
+		t.Errorf("unexpected response: %+v", res)
+	}
+	if transport.count != 1 {
+		t.Errorf("transport count = %d", transport.count)
+	}
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied bool
+	proxy := newOptionTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.Host == "qyapi.weixin.qq.com"
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	})
+	client := NewRobotClientByWebHook("http://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=test", WithProxy(proxy.URL))
+	if _, err := client.SendMessage(NewTextMessage("proxy")); err != nil {
+		t.Fatal("send message error", err)
+	}
+	if !proxied {
+		t.Error("request did not go through proxy")
+	}
+}
+
+func TestWithProxy_Invalid(t *testing.T) {
+	server := newOptionTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent without proxy")
+	})
+	tests := []struct {
+		name string
+		opts []ClientOption
+	}{
+		{"invalid url", []ClientOption{WithProxy("://user:secret@proxy")}},
+		{"no host", []ClientOption{WithProxy("proxy.example.com")}},
+		{"custom transport", []ClientOption{WithTransport(&countTransport{}), WithProxy("http://proxy.example.com:8080")}},
+		{"custom transport tls", []ClientOption{WithTransport(&countTransport{}), WithTLSConfig(&tls.Config{})}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewRobotClientByWebHook(server.URL, tt.opts...)
+			_, err := client.SendMessage(NewTextMessage("proxy"))
+			if err == nil || strings.Contains(err.Error(), "secret") {
+				t.Errorf("err = %v", err)
+			}
+		})
+	}
+}
+
+func TestWithHTTPClient_NotModified(t *testing.T) {
+	transport := &http.Transport{}
+	httpClient := &http.Client{Transport: transport}
+	tlsConfig := &tls.Config{ServerName: "qyapi.weixin.qq.com"}
+	NewRobotClient(WithHTTPClient(httpClient), WithTimeout(time.Second), WithProxy("http://proxy.example.com:8080"), WithTLSConfig(tlsConfig))
+	NewRobotClient(WithHTTPClient(http.DefaultClient), WithTimeout(time.Second), WithProxy("http://proxy.example.com:8080"))
+
+	if httpClient.Timeout != 0 || httpClient.Transport != transport || transport.Proxy != nil {
+		t.Errorf("http.Client modified: %+v", httpClient)
+	}
+	if transport.TLSClientConfig != nil && transport.TLSClientConfig.ServerName != "" {
+		t.Error("http.Transport TLS config modified")
+	}
+	if http.DefaultClient.Timeout != 0 || http.DefaultClient.Transport != nil {
+		t.Error("http.DefaultClient modified")
+	}
+}
@@ -0,0 +1,330 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrQuietHours 免打扰期间消息被丢弃
+	ErrQuietHours = fmt.Errorf("%w: quiet hours", ErrDropped)
+	// ErrDeferred 免打扰期间消息延迟到免打扰结束后发送
+	ErrDeferred = fmt.Errorf("%w: deferred until quiet hours end", ErrAccepted)
+)
+
+// Severity 消息级别
+type Severity int
+
+const (
+	// SeverityInfo 普通
+	SeverityInfo Severity = iota
+	// SeverityWarning 警告
+	SeverityWarning
+	// SeverityCritical 严重
+	SeverityCritical
+)
+
+// QuietAction 免打扰期间的处理方式
+type QuietAction int
+
+const (
+	// QuietDefer 延迟到免打扰结束后发送
+	QuietDefer QuietAction = iota
+	// QuietDrop 丢弃
+	QuietDrop
+)
+
+// QuietHours 免打扰时段，[Start, End) 为一天中的时间，Start 大于 End 时跨越午夜
+type QuietHours struct {
+	// Start 开始时间，距 0 点的时长
+	Start time.Duration
+	// End 结束时间，距 0 点的时长
+	End time.Duration
+	// Location 时区
+	Location *time.Location
+	// Bypass 该级别及以上的消息不受免打扰限制
+	Bypass Severity
+	// Action 低于 Bypass 级别消息的处理方式
+	Action QuietAction
+}
+
+// NewQuietHours create QuietHours, start、end 格式为 15:04，默认严重消息不受限制、其他消息延迟发送
+func NewQuietHours(start, end string, loc *time.Location) (*QuietHours, error) {
+	startTime, err := time.Parse("15:04", start)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := time.Parse("15:04", end)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	return &QuietHours{
+		Start:    time.Duration(startTime.Hour())*time.Hour + time.Duration(startTime.Minute())*time.Minute,
+		End:      time.Duration(endTime.Hour())*time.Hour + time.Duration(endTime.Minute())*time.Minute,
+		Location: loc,
+		Bypass:   SeverityCritical,
+		Action:   QuietDefer,
+	}, nil
+}
+
+// SetBypass set QuietHours.Bypass
+func (quiet *QuietHours) SetBypass(bypass Severity) *QuietHours {
+	quiet.Bypass = bypass
+	return quiet
+}
+
+// SetAction set QuietHours.Action
+func (quiet *QuietHours) SetAction(action QuietAction) *QuietHours {
+	quiet.Action = action
+	return quiet
+}
+
+// timeOfDay t 的挂钟时间距 0 点的时长，夏令时切换当天不受影响
+func timeOfDay(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+}
+
+// dateAt t 所在日期挂钟时间为 d 的时刻，days 为偏移的天数
+func dateAt(t time.Time, days int, d time.Duration) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+days,
+		int(d/time.Hour), int(d%time.Hour/time.Minute), int(d%time.Minute/time.Second), int(d%time.Second), t.Location())
+}
+
+// Contains t 是否在免打扰时段内
+func (quiet *QuietHours) Contains(t time.Time) bool {
+	offset := timeOfDay(t.In(quiet.Location))
+	if quiet.Start <= quiet.End {
+		return offset >= quiet.Start && offset < quiet.End
+	}
+	return offset >= quiet.Start || offset < quiet.End
+}
+
+// NextEnd t 之后最近的免打扰结束时间
+func (quiet *QuietHours) NextEnd(t time.Time) time.Time {
+	local := t.In(quiet.Location)
+	end := dateAt(local, 0, quiet.End)
+	if !end.After(t) {
+		end = dateAt(local, 1, quiet.End)
+	}
+	return end
+}
+
+// EscalationRule 升级规则，告警在 After 内未解决时以 @all 重新发送
+type EscalationRule struct {
+	// After 距告警发送的时长
+	After time.Duration
+	// MinSeverity 该级别及以上的告警才升级
+	MinSeverity Severity
+}
+
+// PolicyOption Policy 配置项
+type PolicyOption func(*Policy)
+
+// WithQuietHours webhook 的免打扰时段，webhook 为空时对所有 webhook 生效
+func WithQuietHours(webhook string, quiet *QuietHours) PolicyOption {
+	return func(policy *Policy) {
+		policy.quietHours[webhook] = quiet
+	}
+}
+
+// WithEscalation 告警升级规则
+func WithEscalation(rules ...EscalationRule) PolicyOption {
+	return func(policy *Policy) {
+		policy.escalations = append(policy.escalations, rules...)
+	}
+}
+
+// WithPolicyClock 时钟，默认 SystemClock
+func WithPolicyClock(clock Clock) PolicyOption {
+	return func(policy *Policy) {
+		policy.clock = clock
+	}
+}
+
+// WithPolicyErrorHandler 延迟发送或升级发送失败时的回调
+func WithPolicyErrorHandler(handler func(error)) PolicyOption {
+	return func(policy *Policy) {
+		policy.onError = handler
+	}
+}
+
+// deferredMessages 免打扰期间延迟发送的消息
+type deferredMessages struct {
+	messages []Message
+	timer    Timer
+}
+
+// alert 待解决的告警
+type alert struct {
+	url      string
+	severity Severity
+	message  Message
+	timers   []Timer
+}
+
+// Policy 按免打扰时段及告警升级规则发送消息
+type Policy struct {
+	sender      Sender
+	quietHours  map[string]*QuietHours
+	escalations []EscalationRule
+	clock       Clock
+	onError     func(error)
+
+	mu       sync.Mutex
+	deferred map[string]*deferredMessages
+	alerts   map[string]*alert
+}
+
+// NewPolicy create Policy
+func NewPolicy(sender Sender, opts ...PolicyOption) *Policy {
+	policy := &Policy{
+		sender:     sender,
+		quietHours: map[string]*QuietHours{},
+		clock:      SystemClock,
+		onError:    func(error) {},
+		deferred:   map[string]*deferredMessages{},
+		alerts:     map[string]*alert{},
+	}
+	for _, opt := range opts {
+		opt(policy)
+	}
+	return policy
+}
+
+// SendSeverity 以 severity 级别发送到 sender 的默认地址，免打扰期间返回 ErrDeferred 或 ErrQuietHours
+func (policy *Policy) SendSeverity(ctx context.Context, severity Severity, message Message) (*RobotResponse, error) {
+	return policy.SendByUrl(ctx, "", severity, message)
+}
+
+// Sender 以 severity 级别发送消息的 Sender
+func (policy *Policy) Sender(severity Severity) Sender {
+	return &delegatingSender{inner: policy.sender, send: func(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+		return policy.SendByUrl(ctx, url, severity, message)
+	}}
+}
+
+// SendByUrl 发送到 url，url 为空时为 sender 的默认地址，免打扰期间返回 ErrDeferred 或 ErrQuietHours
+func (policy *Policy) SendByUrl(ctx context.Context, url string, severity Severity, message Message) (*RobotResponse, error) {
+	if url == "" {
+		url = defaultWebhook(policy.sender)
+	}
+	quiet := policy.quietHours[url]
+	if quiet == nil {
+		quiet = policy.quietHours[""]
+	}
+	now := policy.clock.Now()
+	if quiet == nil || severity >= quiet.Bypass || !quiet.Contains(now) {
+		return policy.sender.Send(ctx, url, message)
+	}
+	if quiet.Action == QuietDrop {
+		return nil, ErrQuietHours
+	}
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+	deferred, ok := policy.deferred[url]
+	if !ok {
+		deferred = &deferredMessages{}
+		deferred.timer = policy.clock.AfterFunc(quiet.NextEnd(now).Sub(now), func() {
+			policy.flushDeferred(url, deferred)
+		})
+		policy.deferred[url] = deferred
+	}
+	deferred.messages = append(deferred.messages, message)
+	return nil, ErrDeferred
+}
+
+// flushDeferred 免打扰结束，发送延迟的消息
+func (policy *Policy) flushDeferred(url string, deferred *deferredMessages) {
+	policy.mu.Lock()
+	if policy.deferred[url] == deferred {
+		delete(policy.deferred, url)
+	}
+	policy.mu.Unlock()
+	for _, message := range deferred.messages {
+		policy.report(policy.sender.Send(context.Background(), url, message))
+	}
+}
+
+// Alert 发送告警到 sender 的默认地址，未在升级规则时间内 Resolve 时以 @all 重新发送
+func (policy *Policy) Alert(ctx context.Context, id string, severity Severity, message Message) (*RobotResponse, error) {
+	return policy.AlertByUrl(ctx, id, "", severity, message)
+}
+
+// AlertByUrl 发送告警到 url，url 为空时为 sender 的默认地址，相同 id 的告警未解决时替换原告警
+func (policy *Policy) AlertByUrl(ctx context.Context, id, url string, severity Severity, message Message) (*RobotResponse, error) {
+	if url == "" {
+		url = defaultWebhook(policy.sender)
+	}
+	policy.Resolve(id)
+	a := &alert{url: url, severity: severity, message: message}
+	policy.mu.Lock()
+	for _, rule := range policy.escalations {
+		if severity < rule.MinSeverity {
+			continue
+		}
+		a.timers = append(a.timers, policy.clock.AfterFunc(rule.After, func() {
+			policy.escalate(id, a)
+		}))
+	}
+	policy.alerts[id] = a
+	policy.mu.Unlock()
+	return policy.SendByUrl(ctx, url, severity, message)
+}
+
+// Resolve 告警已解决，不再升级，告警不存在时返回 false
+func (policy *Policy) Resolve(id string) bool {
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+	a, ok := policy.alerts[id]
+	if !ok {
+		return false
+	}
+	for _, timer := range a.timers {
+		timer.Stop()
+	}
+	delete(policy.alerts, id)
+	return true
+}
+
+// escalate 升级告警
+func (policy *Policy) escalate(id string, a *alert) {
+	policy.mu.Lock()
+	active := policy.alerts[id] == a
+	policy.mu.Unlock()
+	if !active {
+		return
+	}
+	for _, message := range escalationMessages(a.message) {
+		res, err := policy.SendByUrl(context.Background(), a.url, a.severity, message)
+		policy.report(res, err)
+	}
+}
+
+// escalationMessages TextMessage 复制后在 UserIds 中加入 @all，其他消息重新发送并追加一条 @all 提醒
+func escalationMessages(message Message) []Message {
+	if text, ok := message.(*TextMessage); ok {
+		escalated := *text
+		escalated.UserIds = appendUnique(append([]string{}, text.UserIds...), MentionAllUserId)
+		return []Message{&escalated}
+	}
+	return []Message{message, NewTextMessageAtAll("alert not resolved")}
+}
+
+// report 发送失败时调用 onError，延迟发送、汇总或丢弃不是发送失败
+func (policy *Policy) report(res *RobotResponse, err error) {
+	if notSent(err) {
+		return
+	}
+	if err == nil && res != nil && !res.IsSuccess() {
+		err = fmt.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+	}
+	if err != nil {
+		policy.onError(err)
+	}
+}
@@ -0,0 +1,191 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestQuietHours(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	quiet, err := NewQuietHours("22:00", "08:00", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[time.Time]bool{
+		time.Date(2022, 8, 15, 21, 59, 0, 0, loc):         false,
+		time.Date(2022, 8, 15, 22, 0, 0, 0, loc):          true,
+		time.Date(2022, 8, 16, 3, 0, 0, 0, loc):           true,
+		time.Date(2022, 8, 16, 8, 0, 0, 0, loc):           false,
+		time.Date(2022, 8, 15, 15, 0, 0, 0, time.UTC):     true,
+		time.Date(2022, 8, 15, 1, 0, 0, 0, time.UTC):      false,
+		time.Date(2022, 8, 15, 23, 59, 0, 0, time.UTC):    true,
+		time.Date(2022, 8, 15, 12, 30, 0, 0, time.UTC):    false,
+		time.Date(2022, 8, 15, 13, 59, 59, 0, time.UTC):   false,
+		time.Date(2022, 8, 15, 14, 0, 0, 0, time.UTC):     true,
+		time.Date(2022, 8, 15, 23, 59, 59, 0, time.UTC):   true,
+		time.Date(2022, 8, 16, 0, 0, 0, 0, time.UTC):      false,
+		time.Date(2022, 8, 15, 23, 59, 59, 999, time.UTC): true,
+	}
+	for at, want := range cases {
+		if got := quiet.Contains(at); got != want {
+			t.Errorf("Contains(%s) = %v, want %v", at, got, want)
+		}
+	}
+	if end := quiet.NextEnd(time.Date(2022, 8, 15, 23, 0, 0, 0, loc)); !end.Equal(time.Date(2022, 8, 16, 8, 0, 0, 0, loc)) {
+		t.Errorf("NextEnd = %s", end)
+	}
+	if end := quiet.NextEnd(time.Date(2022, 8, 16, 3, 0, 0, 0, loc)); !end.Equal(time.Date(2022, 8, 16, 8, 0, 0, 0, loc)) {
+		t.Errorf("NextEnd = %s", end)
+	}
+	if _, err := NewQuietHours("25:00", "08:00", loc); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestPolicy_QuietHours(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	loc := time.FixedZone("CST", 8*3600)
+	clock := newFakeClock(time.Date(2022, 8, 15, 23, 0, 0, 0, loc))
+	quiet, _ := NewQuietHours("22:00", "08:00", loc)
+	dropQuiet, _ := NewQuietHours("22:00", "08:00", loc)
+	client := NewRobotClientByWebHook(server.Webhook())
+	policy := NewPolicy(client,
+		WithPolicyClock(clock),
+		WithQuietHours("", quiet),
+		WithQuietHours(server.WebhookWithKey("drop"), dropQuiet.SetAction(QuietDrop).SetBypass(SeverityWarning)),
+	)
+	ctx := context.Background()
+
+	if _, err := policy.SendSeverity(ctx, SeverityInfo, NewTextMessage("nightly report")); err != ErrDeferred {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := policy.SendSeverity(ctx, SeverityCritical, NewTextMessage("db down")); err != nil {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := policy.SendByUrl(ctx, server.WebhookWithKey("drop"), SeverityInfo, NewTextMessage("dropped")); !errors.Is(err, ErrDropped) {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := policy.SendByUrl(ctx, server.WebhookWithKey("drop"), SeverityWarning, NewTextMessage("disk 90%")); err != nil {
+		t.Errorf("err = %v", err)
+	}
+	clock.Advance(8*time.Hour + 59*time.Minute)
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"db down", "disk 90%"}) {
+		t.Errorf("contents = %q", contents)
+	}
+	clock.Advance(time.Minute)
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"db down", "disk 90%", "nightly report"}) {
+		t.Errorf("contents = %q", contents)
+	}
+}
+
+func TestPolicy_QuietHoursWrapped(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	loc := time.FixedZone("CST", 8*3600)
+	clock := newFakeClock(time.Date(2022, 8, 15, 23, 0, 0, 0, loc))
+	quiet, _ := NewQuietHours("22:00", "08:00", loc)
+	client := NewRobotClientByWebHook(server.Webhook())
+	for _, sender := range []Sender{WrapSender(client), NewAggregator(WrapSender(client), time.Minute, WithAggregatorClock(clock))} {
+		policy := NewPolicy(sender, WithPolicyClock(clock), WithQuietHours(client.Webhook, quiet))
+		if _, err := policy.SendSeverity(context.Background(), SeverityInfo, NewTextMessage("nightly report")); err != ErrDeferred {
+			t.Errorf("%T: err = %v", sender, err)
+		}
+		if _, err := policy.Sender(SeverityInfo).Send(context.Background(), "", NewTextMessage("nightly report")); err != ErrDeferred {
+			t.Errorf("%T: sender err = %v", sender, err)
+		}
+	}
+	if len(server.Requests()) != 0 {
+		t.Errorf("requests = %d", len(server.Requests()))
+	}
+}
+
+func TestPolicy_Escalation(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	policy := NewPolicy(NewRobotClientByWebHook(server.Webhook()),
+		WithPolicyClock(clock),
+		WithEscalation(EscalationRule{After: 15 * time.Minute, MinSeverity: SeverityWarning}),
+	)
+	ctx := context.Background()
+
+	message := NewTextMessage("api 5xx rate 12%").SetUserIds("zhangsan")
+	if _, err := policy.Alert(ctx, "api-5xx", SeverityCritical, message); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := policy.Alert(ctx, "cpu", SeverityCritical, NewMarkdownMessage("cpu 95%")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := policy.Alert(ctx, "info", SeverityInfo, NewTextMessage("info")); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(10 * time.Minute)
+	if !policy.Resolve("cpu") || policy.Resolve("cpu") {
+		t.Error("resolve failed")
+	}
+	clock.Advance(5 * time.Minute)
+
+	received := server.Messages()
+	if len(received) != 4 {
+		t.Fatalf("received = %v", received)
+	}
+	text := received[3]["text"].(map[string]interface{})
+	if text["content"] != "api 5xx rate 12%" || !reflect.DeepEqual(text["mentioned_list"], []interface{}{"zhangsan", "@all"}) {
+		t.Errorf("escalation = %v", text)
+	}
+	if !reflect.DeepEqual(message.UserIds, []string{"zhangsan"}) {
+		t.Errorf("original message modified: %v", message.UserIds)
+	}
+}
+
+func TestQuietHours_DST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available:", err)
+	}
+	quiet, err := NewQuietHours("22:00", "07:00", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2022-03-13 02:00 夏令时开始，2022-11-06 02:00 夏令时结束
+	if quiet.Contains(time.Date(2022, 3, 13, 7, 30, 0, 0, loc)) || !quiet.Contains(time.Date(2022, 11, 6, 6, 30, 0, 0, loc)) {
+		t.Error("Contains uses elapsed time instead of wall clock")
+	}
+	for _, at := range []time.Time{time.Date(2022, 3, 13, 1, 0, 0, 0, loc), time.Date(2022, 11, 6, 1, 0, 0, 0, loc)} {
+		end := quiet.NextEnd(at)
+		if want := time.Date(at.Year(), at.Month(), at.Day(), 7, 0, 0, 0, loc); !end.Equal(want) {
+			t.Errorf("NextEnd(%s) = %s, want %s", at, end, want)
+		}
+	}
+}
+
+func TestPolicy_EscalationQuietDrop(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	loc := time.FixedZone("CST", 8*3600)
+	quiet, err := NewQuietHours("22:00", "08:00", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := newFakeClock(time.Date(2022, 8, 15, 21, 50, 0, 0, loc))
+	var errs []error
+	policy := NewPolicy(NewRobotClientByWebHook(server.Webhook()),
+		WithPolicyClock(clock),
+		WithQuietHours("", quiet.SetAction(QuietDrop).SetBypass(SeverityCritical)),
+		WithEscalation(EscalationRule{After: 15 * time.Minute, MinSeverity: SeverityWarning}),
+		WithPolicyErrorHandler(func(err error) { errs = append(errs, err) }),
+	)
+	if _, err := policy.AlertByUrl(context.Background(), "disk", server.Webhook(), SeverityWarning, NewTextMessage("disk 90%")); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(15 * time.Minute)
+	if len(server.Messages()) != 1 || len(errs) != 0 {
+		t.Errorf("messages = %d, errs = %v", len(server.Messages()), errs)
+	}
+}
@@ -0,0 +1,441 @@
+package work_weixin_robot
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// previewKind 预览行的类型
+type previewKind int
+
+const (
+	// previewSource 来源
+	previewSource previewKind = iota
+	// previewTitle 标题
+	previewTitle
+	// previewDesc 辅助文字
+	previewDesc
+	// previewEmphasis 关键数据
+	previewEmphasis
+	// previewQuote 引用
+	previewQuote
+	// previewText 正文
+	previewText
+	// previewImage 图片
+	previewImage
+	// previewField 键值对
+	previewField
+	// previewLink 跳转链接
+	previewLink
+	// previewAction 整体卡片点击跳转
+	previewAction
+)
+
+// previewLine 预览中的一行
+type previewLine struct {
+	kind previewKind
+	key  string
+	text string
+	url  string
+}
+
+// previewObject 消息中的 JSON 对象
+type previewObject map[string]interface{}
+
+func (o previewObject) object(key string) previewObject {
+	value, _ := o[key].(map[string]interface{})
+	return value
+}
+
+func (o previewObject) str(key string) string {
+	switch value := o[key].(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	}
+	return ""
+}
+
+func (o previewObject) num(key string) int64 {
+	value, _ := o[key].(json.Number)
+	n, _ := value.Int64()
+	return n
+}
+
+func (o previewObject) list(key string) []previewObject {
+	values, _ := o[key].([]interface{})
+	objects := make([]previewObject, 0, len(values))
+	for _, value := range values {
+		if object, ok := value.(map[string]interface{}); ok {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+func (o previewObject) strs(key string) []string {
+	values, _ := o[key].([]interface{})
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
+
+// clickUrl 点击事件的跳转地址，type 1 为 url，type 2 为小程序
+func (o previewObject) clickUrl() string {
+	switch o.num("type") {
+	case 1:
+		return o.str("url")
+	case 2:
+		return "miniprogram://" + o.str("appid") + "/" + strings.TrimPrefix(o.str("pagepath"), "/")
+	}
+	return ""
+}
+
+// decodePreview 将消息序列化后解析为 JSON 对象，RawMessage 与其他消息一致处理
+func decodePreview(message Message) (previewObject, error) {
+	body, err := json.Marshal(message.ToMessageMap())
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(body)))
+	decoder.UseNumber()
+	object := previewObject{}
+	if err = decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// previewLines 按企业微信中的显示顺序生成预览行
+func previewLines(message Message) ([]previewLine, error) {
+	object, err := decodePreview(message)
+	if err != nil {
+		return nil, err
+	}
+	msgType := object.str("msgtype")
+	content := object.object(msgType)
+	var lines []previewLine
+	switch MsgType(msgType) {
+	case TextMsgType:
+		lines = append(lines, previewLine{kind: previewText, text: content.str("content")})
+		var mentions []string
+		for _, userId := range content.strs("mentioned_list") {
+			mentions = append(mentions, "@"+strings.TrimPrefix(userId, "@"))
+		}
+		for _, mobile := range content.strs("mentioned_mobile_list") {
+			mentions = append(mentions, "@"+strings.TrimPrefix(mobile, "@"))
+		}
+		if len(mentions) > 0 {
+			lines = append(lines, previewLine{kind: previewDesc, text: strings.Join(mentions, " ")})
+		}
+	case MarkdownMsgType:
+		lines = append(lines, previewLine{kind: previewText, text: content.str("content")})
+	case ImageMsgType:
+		size := base64.StdEncoding.DecodedLen(len(content.str("base64")))
+		lines = append(lines, previewLine{kind: previewImage, text: fmt.Sprintf("image %s, md5 %s", formatBytes(size), content.str("md5"))})
+	case NewsMsgTye:
+		for _, article := range content.list("articles") {
+			lines = append(lines, previewLine{kind: previewTitle, text: article.str("title"), url: article.str("url")})
+			if desc := article.str("description"); desc != "" {
+				lines = append(lines, previewLine{kind: previewDesc, text: desc})
+			}
+			if picUrl := article.str("picurl"); picUrl != "" {
+				lines = append(lines, previewLine{kind: previewImage, text: "picture", url: picUrl})
+			}
+		}
+	case FileMsgType:
+		lines = append(lines, previewLine{kind: previewImage, text: "file " + content.str("media_id")})
+	case TemplateCardMsgType:
+		lines = cardPreviewLines(content)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedMessage, msgType)
+	}
+	return lines, nil
+}
+
+// cardPreviewLines 模版卡片：来源、主标题、关键数据、引用、二级文本、图片、列表、跳转
+func cardPreviewLines(card previewObject) []previewLine {
+	var lines []previewLine
+	add := func(kind previewKind, key, text, url string) {
+		if text != "" || url != "" {
+			lines = append(lines, previewLine{kind: kind, key: key, text: text, url: url})
+		}
+	}
+	source := card.object("source")
+	add(previewSource, source.str("desc_color"), source.str("desc"), source.str("icon_url"))
+	mainTitle := card.object("main_title")
+	add(previewTitle, "", mainTitle.str("title"), "")
+	add(previewDesc, "", mainTitle.str("desc"), "")
+	emphasis := card.object("emphasis_content")
+	add(previewEmphasis, emphasis.str("desc"), emphasis.str("title"), "")
+	if imageUrl := card.object("card_image").str("url"); imageUrl != "" {
+		add(previewImage, "", "card image", imageUrl)
+	}
+	area := card.object("image_text_area")
+	if area.str("image_url") != "" {
+		add(previewImage, "", strings.TrimSpace(area.str("title")+" "+area.str("desc")), area.str("image_url"))
+	}
+	quote := card.object("quote_area")
+	add(previewQuote, quote.str("title"), quote.str("quote_text"), quote.clickUrl())
+	add(previewText, "", card.str("sub_title_text"), "")
+	for _, vertical := range card.list("vertical_content_list") {
+		add(previewTitle, "", vertical.str("title"), "")
+		add(previewDesc, "", vertical.str("desc"), "")
+	}
+	for _, horizontal := range card.list("horizontal_content_list") {
+		value, url := horizontal.str("value"), ""
+		switch HorizontalType(horizontal.num("type")) {
+		case UrlHorizontalType:
+			url = horizontal.str("url")
+		case FileHorizontalType:
+			url = "media://" + horizontal.str("media_id")
+		case AtHorizontalType:
+			if value == "" {
+				value = "@" + horizontal.str("userid")
+			}
+		}
+		add(previewField, horizontal.str("keyname"), value, url)
+	}
+	for _, jump := range card.list("jump_list") {
+		add(previewLink, "", jump.str("title"), jump.clickUrl())
+	}
+	add(previewAction, "", "", card.object("card_action").clickUrl())
+	return lines
+}
+
+// formatBytes 可读的字节数
+func formatBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1fMB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1fKB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%dB", n)
+}
+
+// RenderText 以纯文本近似显示消息在企业微信中的效果
+func RenderText(message Message) (string, error) {
+	lines, err := previewLines(message)
+	if err != nil {
+		return "", err
+	}
+	var builder strings.Builder
+	for _, line := range lines {
+		text := line.text
+		switch line.kind {
+		case previewSource:
+			text = "[" + text + "]"
+		case previewTitle:
+			text = "# " + text
+		case previewEmphasis:
+			text = "** " + text + " ** " + line.key
+		case previewQuote:
+			if line.key != "" {
+				text = line.key + "\n" + text
+			}
+			text = "| " + strings.ReplaceAll(text, "\n", "\n| ")
+		case previewImage:
+			text = "[" + text + "]"
+		case previewField:
+			text = line.key + ": " + text
+		case previewLink:
+			text = "> " + text
+		case previewAction:
+			text = "(click) " + line.url
+		}
+		if line.url != "" && line.kind != previewAction && line.kind != previewSource {
+			text += " <" + line.url + ">"
+		}
+		builder.WriteString(strings.TrimRight(text, " "))
+		builder.WriteByte('\n')
+	}
+	return builder.String(), nil
+}
+
+// ANSI 颜色及样式
+const (
+	ansiReset     = "\x1b[0m"
+	ansiBold      = "\x1b[1m"
+	ansiGrey      = "\x1b[90m"
+	ansiRed       = "\x1b[31m"
+	ansiGreen     = "\x1b[32m"
+	ansiLink      = "\x1b[4;34m"
+	ansiEmphasis  = "\x1b[1;36m"
+	ansiCardWidth = 40
+)
+
+// ansi 为 text 的每一行添加样式，避免样式跨行
+func ansi(style, text string) string {
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = style + line + ansiReset
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sourceColor 来源文字颜色，对应 DescColor
+func sourceColor(descColor string) string {
+	switch descColor {
+	case "1":
+		return ansiBold
+	case "2":
+		return ansiRed
+	case "3":
+		return ansiGreen
+	}
+	return ansiGrey
+}
+
+// RenderANSI 以带 ANSI 颜色的文本近似显示消息在企业微信中的效果，模版卡片按卡片布局显示
+func RenderANSI(message Message) (string, error) {
+	lines, err := previewLines(message)
+	if err != nil {
+		return "", err
+	}
+	var builder strings.Builder
+	builder.WriteString("┌" + strings.Repeat("─", ansiCardWidth) + "\n")
+	for _, line := range lines {
+		var text string
+		switch line.kind {
+		case previewSource:
+			text = ansi(sourceColor(line.key), line.text)
+		case previewTitle:
+			text = ansi(ansiBold, line.text)
+		case previewDesc:
+			text = ansi(ansiGrey, line.text)
+		case previewEmphasis:
+			text = ansi(ansiEmphasis, line.text) + "\n" + ansi(ansiGrey, line.key)
+		case previewQuote:
+			quote := ansi(ansiBold, line.key)
+			if quote != "" {
+				quote += "\n"
+			}
+			quote += ansi(ansiGrey, line.text)
+			text = "▌ " + strings.ReplaceAll(quote, "\n", "\n▌ ")
+		case previewImage:
+			text = ansi(ansiGrey, "["+line.text+"]")
+		case previewField:
+			value := line.text
+			if line.url != "" {
+				value = ansi(ansiLink, value)
+			}
+			text = ansi(ansiGrey, line.key) + "  " + value
+		case previewLink:
+			text = ansi(ansiLink, line.text) + " ›"
+		case previewAction:
+			text = ansi(ansiGrey, "⤷ "+line.url)
+		default:
+			text = line.text
+		}
+		if line.url != "" && line.kind != previewSource && line.kind != previewAction {
+			text += " " + ansi(ansiGrey, "<"+line.url+">")
+		}
+		builder.WriteString("│ " + strings.ReplaceAll(strings.TrimRight(text, "\n"), "\n", "\n│ ") + "\n")
+	}
+	builder.WriteString("└" + strings.Repeat("─", ansiCardWidth) + "\n")
+	return builder.String(), nil
+}
+
+// previewClasses 预览行对应的 HTML class
+var previewClasses = map[previewKind]string{
+	previewSource:   "source",
+	previewTitle:    "title",
+	previewDesc:     "desc",
+	previewEmphasis: "emphasis",
+	previewQuote:    "quote",
+	previewText:     "text",
+	previewImage:    "image",
+	previewField:    "field",
+	previewLink:     "link",
+	previewAction:   "action",
+}
+
+// htmlPreviewLine HTML 预览中的一行，Href 仅为 http(s) 地址
+type htmlPreviewLine struct {
+	Class string
+	Key   string
+	Text  string
+	Url   string
+	Href  string
+}
+
+var htmlPreviewTemplate = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { background: #f0f1f3; font-family: -apple-system, "PingFang SC", "Microsoft YaHei", sans-serif; }
+.card { width: 360px; margin: 40px auto; padding: 16px 20px; background: #fff; border-radius: 8px; box-shadow: 0 1px 4px rgba(0,0,0,.1); }
+.card > div { margin: 8px 0; white-space: pre-wrap; word-break: break-all; font-size: 14px; color: #333; }
+.source { color: #888; font-size: 12px !important; }
+.source.c1 { color: #000; } .source.c2 { color: #e64340; } .source.c3 { color: #07c160; }
+.title { font-size: 17px !important; font-weight: bold; color: #000 !important; }
+.desc, .action, .image, .field .key, .emphasis .key { color: #888 !important; }
+.emphasis .value { display: block; font-size: 34px; color: #3274d9; }
+.quote { padding: 6px 10px; background: #f5f6f7; border-left: 3px solid #d8d8d8; color: #888 !important; }
+.quote .key { display: block; color: #333; }
+.field { display: flex; } .field .key { width: 80px; flex-shrink: 0; }
+a { color: #3274d9; text-decoration: none; }
+.link { border-top: 1px solid #eee; padding-top: 8px; }
+.link a::after { content: " ›"; }
+.url { color: #bbb; font-size: 12px; }
+</style>
+</head>
+<body>
+<div class="card">
+{{- range .Lines}}
+<div class="{{.Class}}">
+{{- if eq .Class "emphasis"}}<span class="value">{{.Text}}</span><span class="key">{{.Key}}</span>
+{{- else if eq .Class "quote"}}{{if .Key}}<span class="key">{{.Key}}</span>{{end}}{{.Text}}
+{{- else if eq .Class "field"}}<span class="key">{{.Key}}</span><span class="value">{{if .Href}}<a href="{{.Href}}">{{.Text}}</a>{{else}}{{.Text}}{{end}}</span>
+{{- else if and (eq .Class "image") .Href}}<img src="{{.Href}}" alt="{{.Text}}" style="max-width: 100%">
+{{- else if eq .Class "action"}}点击卡片跳转 <span class="url">{{.Url}}</span>
+{{- else if .Href}}<a href="{{.Href}}">{{.Text}}</a>
+{{- else}}{{.Text}}{{if .Url}} <span class="url">{{.Url}}</span>{{end}}
+{{- end}}</div>
+{{- end}}
+</div>
+</body>
+</html>
+`))
+
+// RenderHTML 以 HTML 页面近似显示消息在企业微信中的效果，模版卡片按卡片布局显示
+func RenderHTML(message Message) (string, error) {
+	lines, err := previewLines(message)
+	if err != nil {
+		return "", err
+	}
+	view := struct {
+		Title string
+		Lines []htmlPreviewLine
+	}{Title: fmt.Sprint(message.ToMessageMap()["msgtype"])}
+	for _, line := range lines {
+		htmlLine := htmlPreviewLine{Class: previewClasses[line.kind], Key: line.key, Text: line.text, Url: line.url}
+		if line.kind == previewSource {
+			htmlLine.Class += " c" + line.key
+			htmlLine.Url = ""
+		}
+		if strings.HasPrefix(line.url, "http://") || strings.HasPrefix(line.url, "https://") {
+			htmlLine.Href = line.url
+		}
+		view.Lines = append(view.Lines, htmlLine)
+	}
+	var builder strings.Builder
+	if err = htmlPreviewTemplate.Execute(&builder, view); err != nil {
+		return "", err
+	}
+	return builder.String(), nil
+}
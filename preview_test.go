@@ -0,0 +1,92 @@
+package work_weixin_robot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newPreviewCard() *CardNewsNoticeMessage {
+	return NewCardNewsNoticeMessage(
+		NewCardMainTitle().SetTitle("欢迎使用<企业微信>").SetDesc("您的好友正在邀请您加入企业微信"),
+		NewCardImage("https://wework.qpic.cn/wwpic/354393_4zpkKXd7SrGMvfg_1629280616/0"),
+		NewCardAction(ClickUrl).SetUrl("https://work.weixin.qq.com/?from=openApi"),
+	).SetSource(
+		NewCardSource().SetDesc("企业微信").SetDescColor(RedDescColor),
+	).SetVerticalContents(
+		NewCardVerticalContent("惊喜红包等你来拿").SetDesc("下载企业微信还能抢红包！"),
+	).AddHorizontalContents(
+		NewCardHorizontalContent("企微官网").SetValue("点击访问").SetType(UrlHorizontalType).SetUrl("https://work.weixin.qq.com/?from=openApi"),
+		NewCardHorizontalContent("脚本").SetValue("恶意链接").SetType(UrlHorizontalType).SetUrl("javascript:alert(1)"),
+	).AddJumps(
+		NewCardJump("企业微信官网").SetType(ClickUrl).SetUrl("https://work.weixin.qq.com/?from=openApi"),
+	)
+}
+
+func TestRenderText(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+		want    string
+	}{
+		{"text", NewTextMessage("hello").AddMobiles("13800001111"), "hello\n@13800001111\n"},
+		{"markdown", NewMarkdownMessage("**bold**"), "**bold**\n"},
+		{"news", NewNewsMessage(NewArticle("title", "https://example.com").SetDesc("desc")), "# title <https://example.com>\ndesc\n"},
+		{"file", NewFileMessage("3a8asd892asd8asd"), "[file 3a8asd892asd8asd]\n"},
+		{"raw", RawMessage(`{"msgtype":"text","text":{"content":"raw"}}`), "raw\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderText(tt.message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if _, err := RenderText(RawMessage(`{"msgtype":"voice"}`)); !errors.Is(err, ErrUnsupportedMessage) {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestRenderANSI(t *testing.T) {
+	got, err := RenderANSI(newPreviewCard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"│ " + ansiRed + "企业微信" + ansiReset + "\n",
+		"│ " + ansiBold + "欢迎使用<企业微信>" + ansiReset + "\n",
+		"│ " + ansiGrey + "企微官网" + ansiReset + "  " + ansiLink + "点击访问" + ansiReset,
+		"│ " + ansiLink + "企业微信官网" + ansiReset + " ›",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in\n%s", want, got)
+		}
+	}
+	if !strings.HasPrefix(got, "┌") || !strings.HasSuffix(got, "─\n") {
+		t.Errorf("no card border:\n%s", got)
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	got, err := RenderHTML(newPreviewCard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"<title>template_card</title>",
+		`<div class="source c2">企业微信</div>`,
+		`<div class="title">欢迎使用&lt;企业微信&gt;</div>`,
+		`<img src="https://wework.qpic.cn/wwpic/354393_4zpkKXd7SrGMvfg_1629280616/0"`,
+		`<span class="key">企微官网</span><span class="value"><a href="https://work.weixin.qq.com/?from=openApi">点击访问</a></span>`,
+		`<span class="key">脚本</span><span class="value">恶意链接</span>`,
+		`<div class="link"><a href="https://work.weixin.qq.com/?from=openApi">企业微信官网</a></div>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in\n%s", want, got)
+		}
+	}
+}
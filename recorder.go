@@ -0,0 +1,207 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+)
+
+// RawMessage 序列化后的消息体，用于重放 golden 文件中的消息
+type RawMessage []byte
+
+// ToMessageMap Message
+func (message RawMessage) ToMessageMap() map[string]interface{} {
+	m := map[string]interface{}{}
+	_ = json.Unmarshal(message, &m)
+	return m
+}
+
+// Recording 记录的一次发送
+type Recording struct {
+	// Url 发送地址，webhook key 已脱敏，为空时为默认地址
+	Url string `json:"url"`
+	// Message 消息，从 golden 文件读取时为 RawMessage
+	Message Message `json:"-"`
+	// Body 序列化后的消息体
+	Body json.RawMessage `json:"body"`
+}
+
+// RecordingSender 记录所有消息而不发送的 Sender，用于 golden 测试
+type RecordingSender struct {
+	mu         sync.Mutex
+	recordings []*Recording
+	response   *RobotResponse
+}
+
+// NewRecordingSender create RecordingSender, Send 返回 errcode 为 0 的结果
+func NewRecordingSender() *RecordingSender {
+	return &RecordingSender{response: &RobotResponse{ErrCode: 0, ErrMsg: "ok"}}
+}
+
+// SetResponse Send 返回的结果
+func (recorder *RecordingSender) SetResponse(response *RobotResponse) *RecordingSender {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.response = response
+	return recorder
+}
+
+// Send Sender, 记录消息及序列化后的消息体
+func (recorder *RecordingSender) Send(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+	request, err := newRobotRequest(url, message)
+	if err != nil {
+		return nil, err
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.recordings = append(recorder.recordings, &Recording{Url: RedactWebhook(url), Message: message, Body: request.Body})
+	response := *recorder.response
+	return &response, nil
+}
+
+// Recordings 按发送顺序记录的消息
+func (recorder *RecordingSender) Recordings() []*Recording {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	return append([]*Recording{}, recorder.recordings...)
+}
+
+// Reset 清空记录
+func (recorder *RecordingSender) Reset() {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.recordings = nil
+}
+
+// Golden 记录序列化为缩进的 JSON 数组
+func (recorder *RecordingSender) Golden() ([]byte, error) {
+	recordings := recorder.Recordings()
+	if recordings == nil {
+		recordings = []*Recording{}
+	}
+	data, err := json.MarshalIndent(recordings, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// WriteGolden 写入 golden 文件
+func (recorder *RecordingSender) WriteGolden(path string) error {
+	data, err := recorder.Golden()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// DiffGolden 与 golden 文件比较，一致时返回空字符串，否则返回逐行差异
+func (recorder *RecordingSender) DiffGolden(path string) (string, error) {
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	got, err := recorder.Golden()
+	if err != nil {
+		return "", err
+	}
+	// golden 文件可能经过手工编辑，统一缩进后比较
+	var normalized bytes.Buffer
+	if err = json.Indent(&normalized, bytes.TrimSpace(want), "", "  "); err != nil {
+		return "", fmt.Errorf("golden file %s: %w", path, err)
+	}
+	normalized.WriteByte('\n')
+	return diffLines(normalized.String(), string(got)), nil
+}
+
+// ReadGolden 读取 golden 文件中的记录，Message 为 RawMessage
+func ReadGolden(path string) ([]*Recording, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var recordings []*Recording
+	if err = json.Unmarshal(data, &recordings); err != nil {
+		return nil, fmt.Errorf("golden file %s: %w", path, err)
+	}
+	for _, recording := range recordings {
+		recording.Message = RawMessage(recording.Body)
+	}
+	return recordings, nil
+}
+
+// Replay 按顺序将记录的消息发送到记录的地址，遇到错误时停止并返回已发送的结果。
+// Url 为空时发送到 sender 的默认地址；记录的 webhook key 已脱敏，发送到 webhooks 中脱敏后与 Url 相同的地址，
+// 没有时返回错误
+func Replay(ctx context.Context, sender Sender, recordings []*Recording, webhooks ...string) ([]*RobotResponse, error) {
+	responses := make([]*RobotResponse, 0, len(recordings))
+	for i, recording := range recordings {
+		url, err := replayUrl(recording.Url, webhooks)
+		if err != nil {
+			return responses, fmt.Errorf("replay %d: %w", i, err)
+		}
+		res, err := sender.Send(ctx, url, recording.Message)
+		if err != nil {
+			return responses, fmt.Errorf("replay %d: %w", i, err)
+		}
+		responses = append(responses, res)
+	}
+	return responses, nil
+}
+
+// replayUrl 记录的地址对应的 webhook
+func replayUrl(recorded string, webhooks []string) (string, error) {
+	for _, webhook := range webhooks {
+		if RedactWebhook(webhook) == recorded {
+			return webhook, nil
+		}
+	}
+	// 脱敏的 key 无法还原
+	if strings.Contains(recorded, "***") {
+		return "", fmt.Errorf("no webhook for %s", recorded)
+	}
+	return recorded, nil
+}
+
+// diffLines 基于最长公共子序列的逐行差异，- 为 want 独有，+ 为 got 独有
+func diffLines(want, got string) string {
+	if want == got {
+		return ""
+	}
+	a := strings.Split(want, "\n")
+	b := strings.Split(got, "\n")
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var builder strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i, j = i+1, j+1
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			fmt.Fprintf(&builder, "+%d: %s\n", j+1, b[j])
+			j++
+		default:
+			fmt.Fprintf(&builder, "-%d: %s\n", i+1, a[i])
+			i++
+		}
+	}
+	return builder.String()
+}
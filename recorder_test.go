@@ -0,0 +1,90 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"flag"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestRecordingSender_Golden(t *testing.T) {
+	recorder := NewRecordingSender()
+	var sender Sender = recorder
+	ctx := context.Background()
+	_, _ = sender.Send(ctx, "", NewTextMessage("deploy finished").AddUserIds("zhangsan"))
+	_, _ = sender.Send(ctx, testWebhook, NewMarkdownMessage("**api** <font color=\"info\">ok</font>"))
+
+	recordings := recorder.Recordings()
+	if len(recordings) != 2 || recordings[1].Url != RedactWebhook(testWebhook) {
+		t.Fatalf("recordings = %+v", recordings)
+	}
+	if _, ok := recordings[0].Message.(*TextMessage); !ok {
+		t.Errorf("message = %T", recordings[0].Message)
+	}
+
+	golden := filepath.Join("testdata", "recorder.golden.json")
+	if *update {
+		if err := recorder.WriteGolden(golden); err != nil {
+			t.Fatal(err)
+		}
+	}
+	diff, err := recorder.DiffGolden(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != "" {
+		t.Errorf("golden mismatch (go test -update to accept):\n%s", diff)
+	}
+
+	_, _ = sender.Send(ctx, "", NewTextMessage("extra"))
+	if diff, _ := recorder.DiffGolden(golden); !strings.Contains(diff, `+`) || !strings.Contains(diff, `"content": "extra"`) {
+		t.Errorf("diff = %q", diff)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	recordings, err := ReadGolden(filepath.Join("testdata", "recorder.golden.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := robottest.NewServer()
+	defer server.Close()
+	client := NewRobotClientByWebHook(server.Webhook())
+	var urls []string
+	sender := SenderFunc(func(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+		urls = append(urls, url)
+		return client.Send(ctx, "", message)
+	})
+	if _, err := Replay(context.Background(), sender, recordings); err == nil || strings.Contains(err.Error(), "91f6") {
+		t.Errorf("err = %v", err)
+	}
+	server.Reset()
+	urls = nil
+	responses, err := Replay(context.Background(), sender, recordings, server.Webhook(), testWebhook)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(urls, []string{"", testWebhook}) {
+		t.Errorf("urls = %q", urls)
+	}
+	if len(responses) != 2 || !responses[0].IsSuccess() || !responses[1].IsSuccess() {
+		t.Errorf("responses = %+v", responses)
+	}
+	want := []string{"deploy finished", "**api** <font color=\"info\">ok</font>"}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, want) {
+		t.Errorf("contents = %q, want %q", contents, want)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	diff := diffLines("a\nb\nc", "a\nx\nc\nd")
+	if diff != "+2: x\n-2: b\n+4: d\n" {
+		t.Errorf("diff = %q", diff)
+	}
+}
@@ -0,0 +1,107 @@
+package work_weixin_robot
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"regexp"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// webhookKeyPattern 匹配 webhook 中的 key 参数
+var webhookKeyPattern = regexp.MustCompile(`([?&]key=)([^&\s"'#]+)`)
+
+// RedactWebhook 对 webhook 中的 key 参数脱敏，仅保留前4位，可用于任意包含 webhook 的字符串
+func RedactWebhook(s string) string {
+	return webhookKeyPattern.ReplaceAllStringFunc(s, func(match string) string {
+		parts := webhookKeyPattern.FindStringSubmatch(match)
+		return parts[1] + maskKey(parts[2])
+	})
+}
+
+// maskKey mask robot key
+func maskKey(key string) string {
+	if len(key) < 8 {
+		return "***"
+	}
+	return key[:4] + "***"
+}
+
+// redactedError error with redacted webhook key
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError redact webhook key of error message
+func redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return &url.Error{
+			Op:  urlErr.Op,
+			URL: RedactWebhook(urlErr.URL),
+			Err: redactError(urlErr.Err),
+		}
+	}
+	msg := err.Error()
+	redacted := RedactWebhook(msg)
+	if redacted == msg {
+		return err
+	}
+	return &redactedError{msg: redacted, err: err}
+}
+
+// redactLogger resty.Logger, redact webhook key of log and debug dump
+type redactLogger struct {
+	logger resty.Logger
+}
+
+func newRedactLogger(logger resty.Logger) *redactLogger {
+	if logger == nil {
+		logger = &stdLogger{l: log.New(os.Stderr, "", log.Ldate|log.Lmicroseconds)}
+	}
+	return &redactLogger{logger: logger}
+}
+
+func (l *redactLogger) Errorf(format string, v ...interface{}) {
+	l.logger.Errorf("%s", RedactWebhook(fmt.Sprintf(format, v...)))
+}
+
+func (l *redactLogger) Warnf(format string, v ...interface{}) {
+	l.logger.Warnf("%s", RedactWebhook(fmt.Sprintf(format, v...)))
+}
+
+func (l *redactLogger) Debugf(format string, v ...interface{}) {
+	l.logger.Debugf("%s", RedactWebhook(fmt.Sprintf(format, v...)))
+}
+
+// stdLogger resty.Logger by log.Logger
+type stdLogger struct {
+	l *log.Logger
+}
+
+func (l *stdLogger) Errorf(format string, v ...interface{}) {
+	l.l.Printf("ERROR RESTY "+format, v...)
+}
+
+func (l *stdLogger) Warnf(format string, v ...interface{}) {
+	l.l.Printf("WARN RESTY "+format, v...)
+}
+
+func (l *stdLogger) Debugf(format string, v ...interface{}) {
+	l.l.Printf("DEBUG RESTY "+format, v...)
+}
@@ -0,0 +1,59 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"log"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const testWebhook = "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa"
+
+func TestRedactWebhook(t *testing.T) {
+	cases := map[string]string{
+		testWebhook: "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=693a***",
+		"https://qyapi.weixin.qq.com/cgi-bin/webhook/upload_media?type=file&key=693a91f6-7xxx": "https://qyapi.weixin.qq.com/cgi-bin/webhook/upload_media?type=file&key=693a***",
+		`Post "https://example.com/send?key=abc": EOF`:                                         `Post "https://example.com/send?key=***": EOF`,
+		"https://example.com/send?monkey=1":                                                    "https://example.com/send?monkey=1",
+		"":                                                                                     "",
+	}
+	for in, want := range cases {
+		if got := RedactWebhook(in); got != want {
+			t.Errorf("RedactWebhook(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestWorkWeixinRobotClient_String(t *testing.T) {
+	client := NewRobotClientByWebHook(testWebhook)
+	if strings.Contains(client.String(), "91f6") {
+		t.Errorf("client string leaks key: %s", client)
+	}
+}
+
+func TestWorkWeixinRobotClient_SendMessageRedactError(t *testing.T) {
+	client := NewRobotClientByWebHook("http://127.0.0.1:0/cgi-bin/webhook/send?key=693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa")
+	_, err := client.SendMessage(NewTextMessage("redact"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if strings.Contains(err.Error(), "91f6") {
+		t.Errorf("error leaks key: %v", err)
+	}
+	if _, ok := err.(*url.Error); !ok {
+		t.Errorf("expected *url.Error, got %T", err)
+	}
+}
+
+func TestRedactLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newRedactLogger(&stdLogger{l: log.New(buf, "", 0)})
+	logger.Debugf("POST %s", testWebhook)
+	if strings.Contains(buf.String(), "91f6") {
+		t.Errorf("log leaks key: %s", buf.String())
+	}
+	if !strings.HasPrefix(buf.String(), "DEBUG RESTY POST") {
+		t.Errorf("unexpected log: %s", buf.String())
+	}
+}
@@ -0,0 +1,63 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+)
+
+// CallbackReplyHandler 处理回调消息并返回被动回复的消息，返回 nil 时不回复。
+// CallbackServer 的 handler 实现该接口时优先调用 ReplyCallback
+type CallbackReplyHandler interface {
+	ReplyCallback(ctx context.Context, message *CallbackMessage) (Message, error)
+}
+
+// CallbackReplyFunc 函数形式的 CallbackReplyHandler
+type CallbackReplyFunc func(ctx context.Context, message *CallbackMessage) (Message, error)
+
+// ReplyCallback CallbackReplyHandler
+func (f CallbackReplyFunc) ReplyCallback(ctx context.Context, message *CallbackMessage) (Message, error) {
+	return f(ctx, message)
+}
+
+// HandleCallback CallbackHandler, 不在 CallbackServer 中使用时回复被丢弃
+func (f CallbackReplyFunc) HandleCallback(ctx context.Context, message *CallbackMessage) error {
+	_, err := f(ctx, message)
+	return err
+}
+
+// CallbackReply 被动回复的加密消息，与回调请求相同，按请求格式序列化为 XML 或 JSON
+type CallbackReply struct {
+	XMLName xml.Name `xml:"xml" json:"-"`
+	// Encrypt 加密后的消息，明文为与 webhook 发送相同的 JSON 消息体
+	Encrypt string `xml:"Encrypt" json:"encrypt"`
+	// MsgSignature 签名
+	MsgSignature string `xml:"MsgSignature" json:"msgsignature"`
+	// TimeStamp 时间戳
+	TimeStamp string `xml:"TimeStamp" json:"timestamp"`
+	// Nonce 随机数
+	Nonce string `xml:"Nonce" json:"nonce"`
+}
+
+// EncryptReply 加密并签名被动回复的消息，支持 TextMessage、MarkdownMessage 及模版卡片等所有 Message
+func (server *CallbackServer) EncryptReply(message Message, timestamp, nonce string) (*CallbackReply, error) {
+	plaintext, err := json.Marshal(message.ToMessageMap())
+	if err != nil {
+		return nil, err
+	}
+	encrypt, signature, err := server.crypt.EncryptMsg(plaintext, timestamp, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &CallbackReply{
+		Encrypt:      encrypt,
+		MsgSignature: signature,
+		TimeStamp:    timestamp,
+		Nonce:        nonce,
+	}, nil
+}
+
+// DecryptReply 校验签名并解密被动回复，返回 JSON 消息体
+func (server *CallbackServer) DecryptReply(reply *CallbackReply) ([]byte, error) {
+	return server.open(reply.MsgSignature, reply.TimeStamp, reply.Nonce, reply.Encrypt)
+}
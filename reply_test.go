@@ -0,0 +1,69 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCallbackServer_EncryptReply(t *testing.T) {
+	server, _ := NewCallbackServer(testCallbackToken, testEncodingAESKey, CallbackHandlerFunc(func(context.Context, *CallbackMessage) error {
+		return nil
+	}))
+	reply, err := server.EncryptReply(NewMarkdownMessage("**done**"), "1409659589", "263014780")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.TimeStamp != "1409659589" || reply.Nonce != "263014780" || reply.MsgSignature == "" {
+		t.Errorf("reply = %+v", reply)
+	}
+	plaintext, err := server.DecryptReply(reply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != `{"markdown":{"content":"**done**"},"msgtype":"markdown"}` {
+		t.Errorf("plaintext = %s", plaintext)
+	}
+	reply.TimeStamp = "1409659590"
+	if _, err := server.DecryptReply(reply); err != ErrInvalidSignature {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestCallbackServer_Reply(t *testing.T) {
+	router := NewCommandRouter(NewRobotClient())
+	router.Register("ping", "check the bot", func(ctx context.Context, command *Command) (Message, error) {
+		return NewTextMessage("pong").AddUserIds(command.Message.From.UserId), nil
+	})
+	server, _ := NewCallbackServer(testCallbackToken, testEncodingAESKey, router)
+
+	recorder := httptest.NewRecorder()
+	plaintext := `<xml><From><UserId>zhangsan</UserId></From><MsgType>text</MsgType><Text><Content>@RobotA ping</Content></Text></xml>`
+	server.ServeHTTP(recorder, newCallbackRequest(t, http.MethodPost, plaintext))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	reply := &CallbackReply{}
+	if err := xml.Unmarshal(recorder.Body.Bytes(), reply); err != nil {
+		t.Fatal(err, recorder.Body.String())
+	}
+	body, err := server.DecryptReply(reply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := map[string]interface{}{}
+	_ = json.Unmarshal(body, &message)
+	text, _ := message["text"].(map[string]interface{})
+	if message["msgtype"] != "text" || text["content"] != "pong" {
+		t.Errorf("reply = %s", body)
+	}
+
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, newCallbackRequest(t, http.MethodPost, `{"msgtype":"event","event":{"eventtype":"add_to_chat"}}`))
+	if recorder.Code != http.StatusOK || recorder.Body.Len() != 0 {
+		t.Errorf("event response = %d %q", recorder.Code, recorder.Body.String())
+	}
+}
@@ -0,0 +1,59 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/robotschema"
+)
+
+const (
+	// BusyErrCode 系统繁忙
+	BusyErrCode = -1
+	// RateLimitErrCode 接口调用超过限制
+	RateLimitErrCode = robotschema.RateLimitErrCode
+)
+
+// retryOptions 重试配置
+type retryOptions struct {
+	count int
+	wait  time.Duration
+}
+
+// WithRetry 请求失败或返回 BusyErrCode、RateLimitErrCode 时重试，count 为最大重试次数
+func WithRetry(count int, wait time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.retry = retryOptions{count: count, wait: wait}
+	}
+}
+
+// shouldRetry 是否需要重试
+func shouldRetry(res *RobotResponse, err error) bool {
+	if err != nil {
+		return true
+	}
+	return res.ErrCode == BusyErrCode || res.ErrCode == RateLimitErrCode
+}
+
+// retry 重试，每次重试 RobotRequest.Retries 加1 并调用 onRetry
+func (options retryOptions) retry(handler Handler, onRetry func(*RobotRequest, *RobotResponse, error)) Handler {
+	if options.count <= 0 {
+		return handler
+	}
+	return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+		res, err := handler(ctx, request)
+		for i := 0; i < options.count && shouldRetry(res, err); i++ {
+			timer := time.NewTimer(options.wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return res, err
+			case <-timer.C:
+			}
+			request.Retries++
+			onRetry(request, res, err)
+			res, err = handler(ctx, request)
+		}
+		return res, err
+	}
+}
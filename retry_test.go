@@ -0,0 +1,81 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestWithRetry(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			_, _ = w.Write([]byte(`{"errcode":45009,"errmsg":"api freq out of limit"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	var retries int
+	client := NewRobotClientByWebHook(server.URL, WithRetry(3, time.Millisecond)).Use(func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			res, err := next(ctx, request)
+			retries = request.Retries
+			return res, err
+		}
+	})
+	res, err := client.SendMessage(NewTextMessage("retry"))
+	if err != nil {
+		t.Fatal("send message error", err)
+	}
+	if !res.IsSuccess() {
+		t.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+	}
+	if calls != 3 || retries != 2 {
+		t.Errorf("calls = %d, retries = %d", calls, retries)
+	}
+}
+
+func TestWithRetryExhausted(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"errcode":-1,"errmsg":"system busy"}`))
+	}))
+	defer server.Close()
+
+	client := NewRobotClientByWebHook(server.URL, WithRetry(2, time.Millisecond))
+	res, err := client.SendMessage(NewTextMessage("retry"))
+	if err != nil {
+		t.Fatal("send message error", err)
+	}
+	if res.ErrCode != BusyErrCode || calls != 3 {
+		t.Errorf("errcode = %d, calls = %d", res.ErrCode, calls)
+	}
+}
+
+func TestWithRetry_ServerError(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	server.Enqueue(robottest.Response{StatusCode: http.StatusBadGateway}, robottest.Response{StatusCode: http.StatusServiceUnavailable})
+
+	client := NewRobotClientByWebHook(server.Webhook())
+	if _, err := client.SendMessage(NewTextMessage("retry")); err == nil || !strings.Contains(err.Error(), "502") {
+		t.Errorf("err = %v", err)
+	}
+	client = NewRobotClientByWebHook(server.Webhook(), WithRetry(2, time.Millisecond))
+	res, err := client.SendMessage(NewTextMessage("retry"))
+	if err != nil {
+		t.Fatal("send message error", err)
+	}
+	if !res.IsSuccess() || len(server.Requests()) != 3 {
+		t.Errorf("res = %+v, requests = %d", res, len(server.Requests()))
+	}
+}
@@ -0,0 +1,364 @@
+// Package robotlog 将日志转发到企业微信机器人
+package robotlog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	robot "github.com/group-robot/work-weixin-robot"
+	"github.com/group-robot/work-weixin-robot/internal/batch"
+)
+
+// MaxContentBytes markdown 内容最大字节数
+const MaxContentBytes = robot.MarkdownMaxBytes
+
+// Level 日志级别，数值与 slog.Level 一致
+type Level int
+
+const (
+	// LevelDebug debug
+	LevelDebug Level = -4
+	// LevelInfo info
+	LevelInfo Level = 0
+	// LevelWarn warn
+	LevelWarn Level = 4
+	// LevelError error
+	LevelError Level = 8
+)
+
+// String level name
+func (level Level) String() string {
+	switch {
+	case level >= LevelError:
+		return "ERROR"
+	case level >= LevelWarn:
+		return "WARN"
+	case level >= LevelInfo:
+		return "INFO"
+	default:
+		return "DEBUG"
+	}
+}
+
+// color markdown font color
+func (level Level) color() string {
+	switch {
+	case level >= LevelWarn:
+		return "warning"
+	case level >= LevelInfo:
+		return "info"
+	default:
+		return "comment"
+	}
+}
+
+// Attr 日志属性
+type Attr struct {
+	Key   string
+	Value interface{}
+}
+
+// Record 日志记录
+type Record struct {
+	Time    time.Time
+	Level   Level
+	Message string
+	Attrs   []Attr
+}
+
+// key 去重 key，属性按 key 排序
+func (record Record) key() string {
+	attrs := make([]string, 0, len(record.Attrs))
+	for _, attr := range record.Attrs {
+		attrs = append(attrs, fmt.Sprintf("%s=%v", attr.Key, attr.Value))
+	}
+	sort.Strings(attrs)
+	return record.Level.String() + "\x00" + record.Message + "\x00" + strings.Join(attrs, "\x00")
+}
+
+// Option Forwarder 配置项
+type Option func(*Forwarder)
+
+// WithLevel 转发的最低日志级别，默认 LevelError
+func WithLevel(level Level) Option {
+	return func(forwarder *Forwarder) {
+		forwarder.level = level
+	}
+}
+
+// WithBatch 达到 size 条或距上次发送超过 interval 时发送，默认 20 条、10 秒，非正数时使用默认值
+func WithBatch(size int, interval time.Duration) Option {
+	return func(forwarder *Forwarder) {
+		forwarder.batchSize = size
+		forwarder.interval = interval
+	}
+}
+
+// WithRateLimit 每分钟最多发送的消息数，默认 20(企业微信机器人限制)
+func WithRateLimit(perMinute int) Option {
+	return func(forwarder *Forwarder) {
+		forwarder.rateLimit = perMinute
+	}
+}
+
+// WithDedupWindow 窗口内相同级别和内容的日志只发送一次并记录重复次数，默认 1 分钟，0 不去重
+func WithDedupWindow(window time.Duration) Option {
+	return func(forwarder *Forwarder) {
+		forwarder.dedupWindow = window
+	}
+}
+
+// WithMaxBuffer 最多缓存的日志条数，超出时丢弃最早的日志，默认 1000
+func WithMaxBuffer(size int) Option {
+	return func(forwarder *Forwarder) {
+		forwarder.maxBuffer = size
+	}
+}
+
+// WithTitle 消息标题
+func WithTitle(title string) Option {
+	return func(forwarder *Forwarder) {
+		forwarder.title = title
+	}
+}
+
+// WithErrorHandler 发送失败时的回调
+func WithErrorHandler(handler func(error)) Option {
+	return func(forwarder *Forwarder) {
+		forwarder.onError = handler
+	}
+}
+
+// entry 缓存的日志
+type entry struct {
+	record Record
+	count  int
+}
+
+// dedup 去重状态
+type dedup struct {
+	first      time.Time
+	record     Record
+	pending    *entry
+	suppressed int
+}
+
+// Forwarder 批量将日志以 MarkdownMessage 发送到企业微信机器人
+type Forwarder struct {
+	sender      robot.Sender
+	level       Level
+	batchSize   int
+	interval    time.Duration
+	rateLimit   int
+	dedupWindow time.Duration
+	maxBuffer   int
+	title       string
+	onError     func(error)
+	now         func() time.Time
+	sleep       func(time.Duration)
+
+	sending sync.Mutex
+	mu      sync.Mutex
+	batcher *batch.Batcher
+	dedups  map[string]*dedup
+
+	notify chan struct{}
+	done   chan struct{}
+	closed sync.Once
+	wg     sync.WaitGroup
+}
+
+// NewForwarder create Forwarder, 需调用 Close 发送剩余日志
+func NewForwarder(sender robot.Sender, opts ...Option) *Forwarder {
+	forwarder := &Forwarder{
+		sender:      sender,
+		level:       LevelError,
+		batchSize:   20,
+		interval:    10 * time.Second,
+		rateLimit:   20,
+		dedupWindow: time.Minute,
+		maxBuffer:   1000,
+		onError:     func(error) {},
+		now:         time.Now,
+		sleep:       time.Sleep,
+		dedups:      map[string]*dedup{},
+		notify:      make(chan struct{}, 1),
+		done:        make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(forwarder)
+	}
+	if forwarder.batchSize <= 0 {
+		forwarder.batchSize = 20
+	}
+	if forwarder.interval <= 0 {
+		forwarder.interval = 10 * time.Second
+	}
+	// 内容末尾另有换行
+	forwarder.batcher = batch.New(MaxContentBytes-1, forwarder.rateLimit, forwarder.maxBuffer,
+		batch.WithHeader(forwarder.header), batch.WithRelease(forwarder.release))
+	forwarder.wg.Add(1)
+	go forwarder.run()
+	return forwarder
+}
+
+// Enabled 是否转发该级别的日志
+func (forwarder *Forwarder) Enabled(level Level) bool {
+	return level >= forwarder.level
+}
+
+// Forward 缓存日志，不会阻塞调用方
+func (forwarder *Forwarder) Forward(record Record) {
+	if !forwarder.Enabled(record.Level) {
+		return
+	}
+	if record.Time.IsZero() {
+		record.Time = forwarder.now()
+	}
+	forwarder.mu.Lock()
+	defer forwarder.mu.Unlock()
+	if forwarder.dedupWindow > 0 {
+		key := record.key()
+		if d, ok := forwarder.dedups[key]; ok && record.Time.Sub(d.first) < forwarder.dedupWindow {
+			if d.pending != nil {
+				d.pending.count++
+			} else {
+				d.suppressed++
+			}
+			return
+		}
+		e := &entry{record: record, count: 1}
+		if d, ok := forwarder.dedups[key]; ok {
+			e.count += d.suppressed
+		}
+		forwarder.dedups[key] = &dedup{first: record.Time, record: record, pending: e}
+		forwarder.batcher.Push(e)
+	} else {
+		forwarder.batcher.Push(&entry{record: record, count: 1})
+	}
+	if forwarder.batcher.Len() >= forwarder.batchSize {
+		select {
+		case forwarder.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// release 日志已发送或丢弃，后续重复日志不再合并到该条
+func (forwarder *Forwarder) release(items []fmt.Stringer) {
+	for _, item := range items {
+		e := item.(*entry)
+		if d, ok := forwarder.dedups[e.record.key()]; ok && d.pending == e {
+			d.pending = nil
+		}
+	}
+}
+
+func (forwarder *Forwarder) run() {
+	defer forwarder.wg.Done()
+	ticker := time.NewTicker(forwarder.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-forwarder.done:
+			return
+		case <-ticker.C:
+		case <-forwarder.notify:
+		}
+		forwarder.Flush()
+	}
+}
+
+// Flush 在频率限制内发送缓存的日志
+func (forwarder *Forwarder) Flush() {
+	forwarder.sending.Lock()
+	defer forwarder.sending.Unlock()
+	for _, content := range forwarder.take() {
+		res, err := forwarder.sender.Send(context.Background(), "", robot.NewMarkdownMessage(content))
+		if err == nil && !res.IsSuccess() {
+			err = fmt.Errorf("robotlog: send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+		}
+		if err != nil {
+			forwarder.onError(err)
+		}
+	}
+}
+
+// take 取出频率限制内可发送的消息内容
+func (forwarder *Forwarder) take() []string {
+	forwarder.mu.Lock()
+	defer forwarder.mu.Unlock()
+	now := forwarder.now()
+	for key, d := range forwarder.dedups {
+		if d.pending != nil || now.Sub(d.first) < forwarder.dedupWindow {
+			continue
+		}
+		if d.suppressed > 0 {
+			e := &entry{record: d.record, count: d.suppressed}
+			forwarder.dedups[key] = &dedup{first: now, record: d.record, pending: e}
+			forwarder.batcher.Push(e)
+		} else {
+			delete(forwarder.dedups, key)
+		}
+	}
+	contents := forwarder.batcher.Take(now)
+	for i := range contents {
+		contents[i] += "\n"
+	}
+	return contents
+}
+
+// wait 距下一次可发送的时间，没有缓存的日志时返回 -1
+func (forwarder *Forwarder) wait() time.Duration {
+	forwarder.mu.Lock()
+	defer forwarder.mu.Unlock()
+	return forwarder.batcher.Wait(forwarder.now())
+}
+
+// Close 停止定时发送并发送剩余日志，超过频率限制时等待，不丢弃缓存的日志
+func (forwarder *Forwarder) Close() error {
+	forwarder.closed.Do(func() {
+		close(forwarder.done)
+		forwarder.wg.Wait()
+		for {
+			forwarder.Flush()
+			wait := forwarder.wait()
+			if wait < 0 {
+				break
+			}
+			forwarder.sleep(wait)
+		}
+	})
+	return nil
+}
+
+// header 消息标题及丢弃的日志数
+func (forwarder *Forwarder) header(dropped int) string {
+	var lines []string
+	if forwarder.title != "" {
+		lines = append(lines, "**"+forwarder.title+"**")
+	}
+	if dropped > 0 {
+		lines = append(lines, fmt.Sprintf("<font color=\"comment\">%d records dropped</font>", dropped))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// String 格式化日志，属性格式化为 key: value 行
+func (e *entry) String() string {
+	var builder strings.Builder
+	record := e.record
+	builder.WriteString(fmt.Sprintf("<font color=\"%s\">%s</font> %s %s",
+		record.Level.color(), record.Level, record.Time.Format("2006-01-02 15:04:05"), record.Message))
+	if e.count > 1 {
+		builder.WriteString(fmt.Sprintf(" (×%d)", e.count))
+	}
+	for _, attr := range record.Attrs {
+		builder.WriteString(fmt.Sprintf("\n> %s: %v", attr.Key, attr.Value))
+	}
+	return builder.String()
+}
@@ -0,0 +1,215 @@
+package robotlog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	robot "github.com/group-robot/work-weixin-robot"
+)
+
+type testServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	contents []string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	server := &testServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		message := struct {
+			MsgType  string `json:"msgtype"`
+			Markdown struct {
+				Content string `json:"content"`
+			} `json:"markdown"`
+		}{}
+		if err := json.Unmarshal(body, &message); err != nil || message.MsgType != "markdown" {
+			t.Errorf("unexpected body: %s", body)
+		}
+		server.mu.Lock()
+		server.contents = append(server.contents, message.Markdown.Content)
+		server.mu.Unlock()
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (server *testServer) messages() []string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]string{}, server.contents...)
+}
+
+func newTestForwarder(server *testServer, now *time.Time, opts ...Option) *Forwarder {
+	opts = append([]Option{WithBatch(100, time.Hour)}, opts...)
+	forwarder := NewForwarder(robot.NewRobotClientByWebHook(server.URL), opts...)
+	forwarder.now = func() time.Time { return *now }
+	return forwarder
+}
+
+func TestForwarder_Forward(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.Local)
+	forwarder := newTestForwarder(server, &now, WithTitle("api"))
+	defer forwarder.Close()
+
+	forwarder.Forward(Record{Level: LevelInfo, Message: "ignored"})
+	forwarder.Forward(Record{Level: LevelError, Message: "db timeout", Attrs: []Attr{{Key: "table", Value: "users"}, {Key: "ms", Value: 300}}})
+	forwarder.Flush()
+
+	messages := server.messages()
+	if len(messages) != 1 {
+		t.Fatalf("messages = %q", messages)
+	}
+	want := "**api**\n<font color=\"warning\">ERROR</font> 2022-08-15 10:00:00 db timeout\n> table: users\n> ms: 300\n"
+	if messages[0] != want {
+		t.Errorf("content = %q, want %q", messages[0], want)
+	}
+}
+
+func TestForwarder_Dedup(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.Local)
+	forwarder := newTestForwarder(server, &now, WithDedupWindow(10*time.Minute))
+	defer forwarder.Close()
+
+	for i := 0; i < 3; i++ {
+		forwarder.Forward(Record{Level: LevelError, Message: "disk full"})
+	}
+	forwarder.Flush()
+	for i := 0; i < 5; i++ {
+		forwarder.Forward(Record{Level: LevelError, Message: "disk full"})
+	}
+	forwarder.Flush()
+	now = now.Add(10 * time.Minute)
+	forwarder.Flush()
+
+	messages := server.messages()
+	if len(messages) != 2 {
+		t.Fatalf("messages = %q", messages)
+	}
+	if !strings.Contains(messages[0], "disk full (×3)") || !strings.Contains(messages[1], "disk full (×5)") {
+		t.Errorf("messages = %q", messages)
+	}
+}
+
+func TestForwarder_DedupAttrs(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.Local)
+	forwarder := newTestForwarder(server, &now, WithDedupWindow(10*time.Minute))
+	defer forwarder.Close()
+
+	forwarder.Forward(Record{Level: LevelError, Message: "request failed", Attrs: []Attr{{Key: "host", Value: "a"}, {Key: "err", Value: "timeout"}}})
+	forwarder.Forward(Record{Level: LevelError, Message: "request failed", Attrs: []Attr{{Key: "err", Value: "timeout"}, {Key: "host", Value: "a"}}})
+	forwarder.Forward(Record{Level: LevelError, Message: "request failed", Attrs: []Attr{{Key: "host", Value: "b"}, {Key: "err", Value: "timeout"}}})
+	forwarder.Flush()
+
+	messages := server.messages()
+	if len(messages) != 1 || strings.Count(messages[0], "request failed") != 2 || !strings.Contains(messages[0], "request failed (×2)") {
+		t.Errorf("messages = %q", messages)
+	}
+}
+
+func TestForwarder_RateLimit(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.Local)
+	forwarder := newTestForwarder(server, &now, WithRateLimit(2), WithDedupWindow(0))
+	defer forwarder.Close()
+
+	long := strings.Repeat("x", 3000)
+	for i := 0; i < 3; i++ {
+		forwarder.Forward(Record{Level: LevelError, Message: long})
+	}
+	forwarder.Flush()
+	if messages := server.messages(); len(messages) != 2 {
+		t.Fatalf("messages = %d", len(messages))
+	}
+	now = now.Add(time.Minute)
+	forwarder.Flush()
+	messages := server.messages()
+	if len(messages) != 3 {
+		t.Fatalf("messages = %d", len(messages))
+	}
+	for _, message := range messages {
+		if len(message) > MaxContentBytes {
+			t.Errorf("message size = %d", len(message))
+		}
+	}
+}
+
+func TestForwarder_MaxBuffer(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.Local)
+	forwarder := newTestForwarder(server, &now, WithMaxBuffer(2), WithDedupWindow(0))
+	defer forwarder.Close()
+
+	for _, message := range []string{"a", "b", "c"} {
+		forwarder.Forward(Record{Level: LevelError, Message: message})
+	}
+	forwarder.Flush()
+	messages := server.messages()
+	if len(messages) != 1 || !strings.HasPrefix(messages[0], "<font color=\"comment\">1 records dropped</font>\n") ||
+		strings.Contains(messages[0], " a\n") {
+		t.Errorf("messages = %q", messages)
+	}
+}
+
+func TestForwarder_Batch(t *testing.T) {
+	server := newTestServer(t)
+	forwarder := NewForwarder(robot.NewRobotClientByWebHook(server.URL), WithBatch(2, time.Hour))
+	forwarder.Forward(Record{Level: LevelError, Message: "a"})
+	forwarder.Forward(Record{Level: LevelError, Message: "b"})
+	deadline := time.Now().Add(time.Second)
+	for len(server.messages()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	forwarder.Forward(Record{Level: LevelError, Message: "c"})
+	_ = forwarder.Close()
+	if messages := server.messages(); len(messages) != 2 {
+		t.Errorf("messages = %q", messages)
+	}
+}
+
+func TestForwarder_CloseRateLimit(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.Local)
+	forwarder := newTestForwarder(server, &now, WithRateLimit(1), WithDedupWindow(0))
+	var slept time.Duration
+	forwarder.sleep = func(d time.Duration) {
+		slept += d
+		now = now.Add(d)
+	}
+
+	long := strings.Repeat("x", 3000)
+	for i := 0; i < 3; i++ {
+		forwarder.Forward(Record{Level: LevelError, Message: long})
+	}
+	_ = forwarder.Close()
+	if messages := server.messages(); len(messages) != 3 {
+		t.Errorf("messages = %d", len(messages))
+	}
+	if slept != 2*time.Minute {
+		t.Errorf("slept = %v", slept)
+	}
+}
+
+func TestWithBatch_NonPositive(t *testing.T) {
+	server := newTestServer(t)
+	forwarder := NewForwarder(robot.NewRobotClientByWebHook(server.URL), WithBatch(0, 0))
+	defer forwarder.Close()
+	if forwarder.batchSize != 20 || forwarder.interval != 10*time.Second {
+		t.Errorf("batch = %d, interval = %v", forwarder.batchSize, forwarder.interval)
+	}
+	forwarder = NewForwarder(robot.NewRobotClientByWebHook(server.URL), WithBatch(5, -time.Second))
+	defer forwarder.Close()
+	if forwarder.interval != 10*time.Second {
+		t.Errorf("interval = %v", forwarder.interval)
+	}
+}
@@ -0,0 +1,88 @@
+//go:build go1.21
+// +build go1.21
+
+package robotlog
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Handler slog.Handler, 将日志转发到 Forwarder
+type Handler struct {
+	forwarder *Forwarder
+	attrs     []Attr
+	group     string
+}
+
+// NewHandler create Handler
+func NewHandler(forwarder *Forwarder) *Handler {
+	return &Handler{forwarder: forwarder}
+}
+
+// Enabled slog.Handler
+func (handler *Handler) Enabled(_ context.Context, level slog.Level) bool {
+	return handler.forwarder.Enabled(Level(level))
+}
+
+// Handle slog.Handler
+func (handler *Handler) Handle(_ context.Context, record slog.Record) error {
+	attrs := append([]Attr{}, handler.attrs...)
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = appendAttr(attrs, handler.group, attr)
+		return true
+	})
+	handler.forwarder.Forward(Record{
+		Time:    record.Time,
+		Level:   Level(record.Level),
+		Message: record.Message,
+		Attrs:   attrs,
+	})
+	return nil
+}
+
+// WithAttrs slog.Handler
+func (handler *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h := *handler
+	h.attrs = append([]Attr{}, handler.attrs...)
+	for _, attr := range attrs {
+		h.attrs = appendAttr(h.attrs, handler.group, attr)
+	}
+	return &h
+}
+
+// WithGroup slog.Handler
+func (handler *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return handler
+	}
+	h := *handler
+	h.group = join(handler.group, name)
+	return &h
+}
+
+// appendAttr 展开分组属性，key 以 . 连接
+func appendAttr(attrs []Attr, group string, attr slog.Attr) []Attr {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		prefix := group
+		if attr.Key != "" {
+			prefix = join(group, attr.Key)
+		}
+		for _, a := range value.Group() {
+			attrs = appendAttr(attrs, prefix, a)
+		}
+		return attrs
+	}
+	if attr.Key == "" {
+		return attrs
+	}
+	return append(attrs, Attr{Key: join(group, attr.Key), Value: value.Any()})
+}
+
+func join(group, key string) string {
+	if group == "" {
+		return key
+	}
+	return group + "." + key
+}
@@ -0,0 +1,33 @@
+//go:build go1.21
+// +build go1.21
+
+package robotlog
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	server := newTestServer(t)
+	now := time.Date(2022, 8, 15, 10, 0, 0, 0, time.Local)
+	forwarder := newTestForwarder(server, &now, WithLevel(LevelWarn))
+	defer forwarder.Close()
+
+	logger := slog.New(NewHandler(forwarder)).With("service", "api").WithGroup("req")
+	logger.Info("ignored")
+	logger.Warn("slow request", "path", "/users", slog.Group("db", "ms", 300))
+	forwarder.Flush()
+
+	messages := server.messages()
+	if len(messages) != 1 {
+		t.Fatalf("messages = %q", messages)
+	}
+	for _, line := range []string{"WARN</font>", "slow request\n", "> service: api\n", "> req.path: /users\n", "> req.db.ms: 300\n"} {
+		if !strings.Contains(messages[0], line) {
+			t.Errorf("missing %q in %q", line, messages[0])
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// Package robotschema 按企业微信机器人 webhook 接口文档校验消息体
+package robotschema
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// 企业微信返回的错误码
+const (
+	// InvalidParameterErrCode 参数不合法
+	InvalidParameterErrCode = 40058
+	// InvalidMsgTypeErrCode 不合法的消息类型
+	InvalidMsgTypeErrCode = 40008
+	// InvalidMediaSizeErrCode 不合法的媒体文件大小
+	InvalidMediaSizeErrCode = 40006
+	// RateLimitErrCode 接口调用超过限制
+	RateLimitErrCode = 45009
+	// InvalidWebhookErrCode 不合法的 webhook 地址
+	InvalidWebhookErrCode = 93000
+)
+
+const (
+	// TextMaxBytes text 消息 content 最大字节数
+	TextMaxBytes = 2048
+	// MarkdownMaxBytes markdown 消息 content 最大字节数
+	MarkdownMaxBytes = 4096
+	// ImageMaxBytes image 消息图片最大字节数
+	ImageMaxBytes = 2 << 20
+	// MediaMinBytes upload_media 文件最小字节数
+	MediaMinBytes = 5
+	// MediaMaxBytes upload_media 文件最大字节数
+	MediaMaxBytes = 20 << 20
+)
+
+// ValidationError 消息体不符合 webhook 接口要求
+type ValidationError struct {
+	// ErrCode 企业微信返回的错误码
+	ErrCode int
+	// ErrMsg 错误信息
+	ErrMsg string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("errcode %d: %s", e.ErrCode, e.ErrMsg)
+}
+
+func invalid(format string, args ...interface{}) *ValidationError {
+	return &ValidationError{ErrCode: InvalidParameterErrCode, ErrMsg: fmt.Sprintf(format, args...)}
+}
+
+// object 消息中的 JSON 对象
+type object map[string]interface{}
+
+func (o object) object(key string) (object, bool) {
+	value, ok := o[key].(map[string]interface{})
+	return value, ok
+}
+
+func (o object) string(key string) string {
+	value, _ := o[key].(string)
+	return value
+}
+
+func (o object) number(key string) float64 {
+	value, _ := o[key].(float64)
+	return value
+}
+
+func (o object) array(key string) []interface{} {
+	value, _ := o[key].([]interface{})
+	return value
+}
+
+// Validate 按 webhook 接口文档校验消息体，返回 *ValidationError
+func Validate(body []byte) error {
+	message := object{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if err := decoder.Decode(&message); err != nil {
+		return invalid("invalid json: %v", err)
+	}
+	msgType := message.string("msgtype")
+	if msgType == "" {
+		return &ValidationError{ErrCode: InvalidMsgTypeErrCode, ErrMsg: "missing msgtype"}
+	}
+	content, ok := message.object(msgType)
+	switch msgType {
+	case "text":
+		if !ok {
+			return invalid("missing text")
+		}
+		return validateContent(content, TextMaxBytes, "mentioned_list", "mentioned_mobile_list")
+	case "markdown":
+		if !ok {
+			return invalid("missing markdown")
+		}
+		return validateContent(content, MarkdownMaxBytes)
+	case "image":
+		if !ok {
+			return invalid("missing image")
+		}
+		return validateImage(content)
+	case "news":
+		if !ok {
+			return invalid("missing news")
+		}
+		return validateNews(content)
+	case "file":
+		if !ok || content.string("media_id") == "" {
+			return invalid("missing file.media_id")
+		}
+		return nil
+	case "template_card":
+		if !ok {
+			return invalid("missing template_card")
+		}
+		return validateCard(content)
+	default:
+		return &ValidationError{ErrCode: InvalidMsgTypeErrCode, ErrMsg: fmt.Sprintf("invalid message type %q", msgType)}
+	}
+}
+
+func validateContent(content object, max int, lists ...string) error {
+	text := content.string("content")
+	if text == "" {
+		return invalid("empty content")
+	}
+	if len(text) > max {
+		return invalid("content exceeds %d bytes", max)
+	}
+	for _, list := range lists {
+		value, exists := content[list]
+		if !exists {
+			continue
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			return invalid("%s must be an array of strings", list)
+		}
+		for _, item := range items {
+			if _, ok := item.(string); !ok {
+				return invalid("%s must be an array of strings", list)
+			}
+		}
+	}
+	return nil
+}
+
+func validateImage(image object) error {
+	data, err := base64.StdEncoding.DecodeString(image.string("base64"))
+	if err != nil || len(data) == 0 {
+		return invalid("invalid image.base64")
+	}
+	if len(data) > ImageMaxBytes {
+		return &ValidationError{ErrCode: InvalidMediaSizeErrCode, ErrMsg: "image exceeds 2MB"}
+	}
+	sum := md5.Sum(data)
+	if image.string("md5") != hex.EncodeToString(sum[:]) {
+		return invalid("image.md5 does not match image.base64")
+	}
+	return nil
+}
+
+func validateNews(news object) error {
+	articles := news.array("articles")
+	if len(articles) == 0 || len(articles) > 8 {
+		return invalid("news.articles must contain 1 to 8 articles")
+	}
+	for i, value := range articles {
+		article, _ := value.(map[string]interface{})
+		if object(article).string("title") == "" || object(article).string("url") == "" {
+			return invalid("news.articles[%d]: missing title or url", i)
+		}
+	}
+	return nil
+}
+
+func validateCard(card object) error {
+	cardType := card.string("card_type")
+	mainTitle, _ := card.object("main_title")
+	switch cardType {
+	case "text_notice":
+		if mainTitle.string("title") == "" && card.string("sub_title_text") == "" {
+			return invalid("text_notice: missing main_title.title or sub_title_text")
+		}
+	case "news_notice":
+		if mainTitle.string("title") == "" {
+			return invalid("news_notice: missing main_title.title")
+		}
+		image, hasImage := card.object("card_image")
+		area, hasArea := card.object("image_text_area")
+		if (!hasImage || image.string("url") == "") && (!hasArea || area.string("image_url") == "") {
+			return invalid("news_notice: missing card_image or image_text_area")
+		}
+	default:
+		return invalid("invalid card_type %q", cardType)
+	}
+	action, ok := card.object("card_action")
+	if !ok {
+		return invalid("missing card_action")
+	}
+	if err := validateAction("card_action", action); err != nil {
+		return err
+	}
+	if len(card.array("horizontal_content_list")) > 6 {
+		return invalid("horizontal_content_list exceeds 6 items")
+	}
+	if len(card.array("vertical_content_list")) > 4 {
+		return invalid("vertical_content_list exceeds 4 items")
+	}
+	jumps := card.array("jump_list")
+	if len(jumps) > 3 {
+		return invalid("jump_list exceeds 3 items")
+	}
+	for i, value := range jumps {
+		jump, _ := value.(map[string]interface{})
+		if object(jump).string("title") == "" {
+			return invalid("jump_list[%d]: missing title", i)
+		}
+		if object(jump).number("type") != 0 {
+			if err := validateAction(fmt.Sprintf("jump_list[%d]", i), jump); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateAction type 1 跳转 url，type 2 跳转小程序
+func validateAction(name string, action object) error {
+	switch action.number("type") {
+	case 1:
+		if action.string("url") == "" {
+			return invalid("%s: missing url", name)
+		}
+	case 2:
+		if action.string("appid") == "" {
+			return invalid("%s: missing appid", name)
+		}
+	default:
+		return invalid("%s: invalid type", name)
+	}
+	return nil
+}
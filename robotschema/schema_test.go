@@ -0,0 +1,47 @@
+package robotschema
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	card := `{"msgtype":"template_card","template_card":{"card_type":"text_notice","main_title":{"title":"t"},"card_action":{"type":1,"url":"https://example.com"}%s}}`
+	tests := []struct {
+		name    string
+		body    string
+		errCode int
+	}{
+		{"text", `{"msgtype":"text","text":{"content":"hello"}}`, 0},
+		{"text too long", `{"msgtype":"text","text":{"content":"` + strings.Repeat("a", TextMaxBytes+1) + `"}}`, InvalidParameterErrCode},
+		{"text mentions", `{"msgtype":"text","text":{"content":"hello","mentioned_list":"@all"}}`, InvalidParameterErrCode},
+		{"markdown", `{"msgtype":"markdown","markdown":{"content":"` + strings.Repeat("a", MarkdownMaxBytes) + `"}}`, 0},
+		{"image", `{"msgtype":"image","image":{"base64":"aGVsbG8=","md5":"5d41402abc4b2a76b9719d911017c592"}}`, 0},
+		{"image md5", `{"msgtype":"image","image":{"base64":"aGVsbG8=","md5":"00000000000000000000000000000000"}}`, InvalidParameterErrCode},
+		{"news", `{"msgtype":"news","news":{"articles":[{"title":"t","url":"https://example.com"}]}}`, 0},
+		{"news empty", `{"msgtype":"news","news":{"articles":[]}}`, InvalidParameterErrCode},
+		{"news url", `{"msgtype":"news","news":{"articles":[{"title":"t"}]}}`, InvalidParameterErrCode},
+		{"file", `{"msgtype":"file","file":{"media_id":""}}`, InvalidParameterErrCode},
+		{"card", strings.Replace(card, "%s", `,"jump_list":[{"title":"j","type":2,"appid":"wx"}]`, 1), 0},
+		{"card jump", strings.Replace(card, "%s", `,"jump_list":[{"title":"j","type":1}]`, 1), InvalidParameterErrCode},
+		{"card action", `{"msgtype":"template_card","template_card":{"card_type":"text_notice","main_title":{"title":"t"},"card_action":{"type":0}}}`, InvalidParameterErrCode},
+		{"news notice image", `{"msgtype":"template_card","template_card":{"card_type":"news_notice","main_title":{"title":"t"},"card_action":{"type":1,"url":"u"}}}`, InvalidParameterErrCode},
+		{"unknown msgtype", `{"msgtype":"voice","voice":{}}`, InvalidMsgTypeErrCode},
+		{"missing msgtype", `{"text":{"content":"hello"}}`, InvalidMsgTypeErrCode},
+		{"invalid json", `{`, InvalidParameterErrCode},
+	}
+	for _, tt := range tests {
+		err := Validate([]byte(tt.body))
+		if tt.errCode == 0 {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		var invalid *ValidationError
+		if !errors.As(err, &invalid) || invalid.ErrCode != tt.errCode {
+			t.Errorf("%s: err = %v, want errcode %d", tt.name, err, tt.errCode)
+		}
+	}
+}
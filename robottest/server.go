@@ -0,0 +1,346 @@
+// Package robottest 基于 httptest 的企业微信机器人 webhook 模拟服务，用于测试：
+// 接收 webhook 消息及 upload_media 上传、校验消息体、记录收到的消息，
+// 并可指定返回的错误码、延迟及限流
+package robottest
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/robotschema"
+)
+
+// 企业微信返回的错误码，同 robotschema
+const (
+	// InvalidParameterErrCode 参数不合法
+	InvalidParameterErrCode = robotschema.InvalidParameterErrCode
+	// InvalidMsgTypeErrCode 不合法的消息类型
+	InvalidMsgTypeErrCode = robotschema.InvalidMsgTypeErrCode
+	// InvalidMediaSizeErrCode 不合法的媒体文件大小
+	InvalidMediaSizeErrCode = robotschema.InvalidMediaSizeErrCode
+	// RateLimitErrCode 接口调用超过限制
+	RateLimitErrCode = robotschema.RateLimitErrCode
+	// InvalidWebhookErrCode 不合法的 webhook 地址
+	InvalidWebhookErrCode = robotschema.InvalidWebhookErrCode
+)
+
+const (
+	// SendPath webhook 发送消息路径
+	SendPath = "/cgi-bin/webhook/send"
+	// UploadPath webhook 上传文件路径
+	UploadPath = "/cgi-bin/webhook/upload_media"
+	// DefaultKey Server.Webhook 使用的 key
+	DefaultKey = "robottest"
+)
+
+// Response 指定的返回结果
+type Response struct {
+	// ErrCode 错误码
+	ErrCode int
+	// ErrMsg 错误信息，为空时根据 ErrCode 生成
+	ErrMsg string
+	// StatusCode HTTP 状态码，默认 200
+	StatusCode int
+	// Latency 返回前的延迟
+	Latency time.Duration
+}
+
+// Request 收到的 webhook 消息
+type Request struct {
+	// Key webhook key
+	Key string
+	// Body 请求体
+	Body []byte
+	// Message 解析后的消息，请求体不是 JSON 时为 nil
+	Message map[string]interface{}
+	// MsgType 消息类型
+	MsgType string
+	// ErrCode 返回的错误码
+	ErrCode int
+	// ErrMsg 返回的错误信息
+	ErrMsg string
+	// Time 收到请求的时间
+	Time time.Time
+}
+
+// Upload 收到的 upload_media 上传
+type Upload struct {
+	// Key webhook key
+	Key string
+	// Type 文件类型，file 或 voice
+	Type string
+	// Filename 文件名
+	Filename string
+	// Data 文件内容
+	Data []byte
+	// MediaId 返回的 media_id，上传失败时为空
+	MediaId string
+	// ErrCode 返回的错误码
+	ErrCode int
+}
+
+// Server 模拟的 webhook 服务
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	requests   []*Request
+	uploads    []*Upload
+	script     []Response
+	latency    time.Duration
+	rateLimit  int
+	rateWindow time.Duration
+}
+
+// NewServer 启动模拟服务，使用后调用 Close
+func NewServer() *Server {
+	server := &Server{}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// Webhook 使用 DefaultKey 的 webhook 地址
+func (server *Server) Webhook() string {
+	return server.WebhookWithKey(DefaultKey)
+}
+
+// WebhookWithKey 使用 key 的 webhook 地址
+func (server *Server) WebhookWithKey(key string) string {
+	return server.URL + SendPath + "?key=" + key
+}
+
+// UploadUrl 使用 key 的 upload_media 地址，type 为 file 或 voice
+func (server *Server) UploadUrl(key, mediaType string) string {
+	return server.URL + UploadPath + "?key=" + key + "&type=" + mediaType
+}
+
+// Enqueue 按顺序为之后的 webhook 消息返回指定的结果，用完后恢复正常处理
+func (server *Server) Enqueue(responses ...Response) *Server {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.script = append(server.script, responses...)
+	return server
+}
+
+// SetLatency 每个请求返回前的延迟
+func (server *Server) SetLatency(latency time.Duration) *Server {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.latency = latency
+	return server
+}
+
+// SetRateLimit 每个 key 在 window 内最多接收 limit 条消息，超过时返回 RateLimitErrCode，
+// 企业微信的限制为每分钟 20 条
+func (server *Server) SetRateLimit(limit int, window time.Duration) *Server {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.rateLimit = limit
+	server.rateWindow = window
+	return server
+}
+
+// Requests 收到的所有 webhook 消息
+func (server *Server) Requests() []*Request {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]*Request{}, server.requests...)
+}
+
+// Messages 成功接收(errcode 为 0)的消息
+func (server *Server) Messages() []map[string]interface{} {
+	var messages []map[string]interface{}
+	for _, request := range server.Requests() {
+		if request.ErrCode == 0 {
+			messages = append(messages, request.Message)
+		}
+	}
+	return messages
+}
+
+// Contents 成功接收的 text、markdown 消息内容
+func (server *Server) Contents() []string {
+	var contents []string
+	for _, message := range server.Messages() {
+		msgType, _ := message["msgtype"].(string)
+		if msgType != "text" && msgType != "markdown" {
+			continue
+		}
+		content, _ := message[msgType].(map[string]interface{})["content"].(string)
+		contents = append(contents, content)
+	}
+	return contents
+}
+
+// Uploads 收到的所有 upload_media 上传
+func (server *Server) Uploads() []*Upload {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]*Upload{}, server.uploads...)
+}
+
+// Reset 清空记录、指定的结果及限流计数
+func (server *Server) Reset() {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.requests = nil
+	server.uploads = nil
+	server.script = nil
+}
+
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	switch r.URL.Path {
+	case SendPath:
+		server.send(w, r)
+	case UploadPath:
+		server.upload(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// send 处理 webhook 消息：指定的结果优先，其次为限流及消息体校验
+func (server *Server) send(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	request := &Request{Key: r.URL.Query().Get("key"), Body: body, Time: time.Now()}
+	if err := json.Unmarshal(body, &request.Message); err == nil {
+		request.MsgType, _ = request.Message["msgtype"].(string)
+	}
+
+	server.mu.Lock()
+	response, scripted := server.next()
+	latency := server.latency
+	switch {
+	case scripted:
+	case request.Key == "":
+		response = Response{ErrCode: InvalidWebhookErrCode, ErrMsg: "invalid webhook url"}
+	case server.limited(request):
+		response = Response{ErrCode: RateLimitErrCode, ErrMsg: "api freq out of limit"}
+	default:
+		if err := robotschema.Validate(body); err != nil {
+			invalid := err.(*robotschema.ValidationError)
+			response = Response{ErrCode: invalid.ErrCode, ErrMsg: invalid.ErrMsg}
+		}
+	}
+	request.ErrCode, request.ErrMsg = response.ErrCode, errMsg(response)
+	server.requests = append(server.requests, request)
+	server.mu.Unlock()
+
+	if !sleep(r, latency+response.Latency) {
+		return
+	}
+	if response.StatusCode != 0 && response.StatusCode != http.StatusOK {
+		http.Error(w, http.StatusText(response.StatusCode), response.StatusCode)
+		return
+	}
+	writeJSON(w, map[string]interface{}{"errcode": request.ErrCode, "errmsg": request.ErrMsg})
+}
+
+// upload 处理 upload_media 上传，文件在 multipart 的 media 字段中
+func (server *Server) upload(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	upload := &Upload{Key: query.Get("key"), Type: query.Get("type")}
+	errMsg := "ok"
+	if file, header, err := r.FormFile("media"); err == nil {
+		upload.Filename = header.Filename
+		upload.Data, _ = io.ReadAll(file)
+		_ = file.Close()
+	}
+	switch {
+	case upload.Key == "":
+		upload.ErrCode, errMsg = InvalidWebhookErrCode, "invalid webhook url"
+	case upload.Type != "file" && upload.Type != "voice":
+		upload.ErrCode, errMsg = InvalidParameterErrCode, "invalid media type"
+	case len(upload.Data) < robotschema.MediaMinBytes || len(upload.Data) > robotschema.MediaMaxBytes:
+		upload.ErrCode, errMsg = InvalidMediaSizeErrCode, "invalid media size"
+	default:
+		sum := md5.Sum(upload.Data)
+		upload.MediaId = upload.Type + "-" + hex.EncodeToString(sum[:])
+	}
+
+	server.mu.Lock()
+	server.uploads = append(server.uploads, upload)
+	latency := server.latency
+	server.mu.Unlock()
+
+	if !sleep(r, latency) {
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"errcode":    upload.ErrCode,
+		"errmsg":     errMsg,
+		"type":       upload.Type,
+		"media_id":   upload.MediaId,
+		"created_at": strconv.FormatInt(time.Now().Unix(), 10),
+	})
+}
+
+// next 取出下一个指定的结果
+func (server *Server) next() (Response, bool) {
+	if len(server.script) == 0 {
+		return Response{}, false
+	}
+	response := server.script[0]
+	server.script = server.script[1:]
+	return response, true
+}
+
+// limited key 在限流窗口内成功接收的消息是否已达到上限
+func (server *Server) limited(request *Request) bool {
+	if server.rateLimit <= 0 {
+		return false
+	}
+	count := 0
+	for _, sent := range server.requests {
+		if sent.Key == request.Key && sent.ErrCode == 0 && request.Time.Sub(sent.Time) < server.rateWindow {
+			count++
+		}
+	}
+	return count >= server.rateLimit
+}
+
+// errMsg 未指定 ErrMsg 时根据 ErrCode 生成
+func errMsg(response Response) string {
+	switch {
+	case response.ErrMsg != "":
+		return response.ErrMsg
+	case response.ErrCode == 0:
+		return "ok"
+	case response.ErrCode == RateLimitErrCode:
+		return "api freq out of limit"
+	default:
+		return fmt.Sprintf("errcode %d", response.ErrCode)
+	}
+}
+
+// sleep 等待 d，请求取消时返回 false
+func sleep(r *http.Request, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
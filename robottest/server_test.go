@@ -0,0 +1,145 @@
+package robottest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type result struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+	MediaId string `json:"media_id"`
+}
+
+func post(t *testing.T, url, body string) result {
+	res, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	r := result{}
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestServer_Send(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	if r := post(t, server.Webhook(), `{"msgtype":"text","text":{"content":"hello","mentioned_list":["@all"]}}`); r.ErrCode != 0 || r.ErrMsg != "ok" {
+		t.Errorf("text = %+v", r)
+	}
+	if r := post(t, server.Webhook(), `{"msgtype":"markdown","markdown":{"content":""}}`); r.ErrCode != InvalidParameterErrCode {
+		t.Errorf("empty markdown = %+v", r)
+	}
+	if r := post(t, server.URL+SendPath, `{"msgtype":"text","text":{"content":"hello"}}`); r.ErrCode != InvalidWebhookErrCode {
+		t.Errorf("missing key = %+v", r)
+	}
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"hello"}) {
+		t.Errorf("contents = %q", contents)
+	}
+	requests := server.Requests()
+	if len(requests) != 3 || requests[1].MsgType != "markdown" || requests[1].ErrCode != InvalidParameterErrCode {
+		t.Errorf("requests = %+v", requests)
+	}
+	server.Reset()
+	if len(server.Requests()) != 0 {
+		t.Error("requests not reset")
+	}
+}
+
+func TestServer_Enqueue(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Enqueue(Response{ErrCode: -1, ErrMsg: "system busy"}, Response{StatusCode: http.StatusBadGateway})
+
+	if r := post(t, server.Webhook(), `{"msgtype":"text","text":{"content":"a"}}`); r.ErrCode != -1 || r.ErrMsg != "system busy" {
+		t.Errorf("scripted = %+v", r)
+	}
+	res, err := http.Post(server.Webhook(), "application/json", strings.NewReader(`{"msgtype":"text","text":{"content":"b"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadGateway {
+		t.Errorf("status = %d", res.StatusCode)
+	}
+	if r := post(t, server.Webhook(), `{"msgtype":"text","text":{"content":"c"}}`); r.ErrCode != 0 {
+		t.Errorf("after script = %+v", r)
+	}
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.SetRateLimit(2, time.Minute)
+
+	var codes []int
+	for i := 0; i < 3; i++ {
+		codes = append(codes, post(t, server.Webhook(), `{"msgtype":"text","text":{"content":"a"}}`).ErrCode)
+	}
+	codes = append(codes, post(t, server.WebhookWithKey("other"), `{"msgtype":"text","text":{"content":"a"}}`).ErrCode)
+	if !reflect.DeepEqual(codes, []int{0, 0, RateLimitErrCode, 0}) {
+		t.Errorf("codes = %v", codes)
+	}
+}
+
+func TestServer_Latency(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.SetLatency(time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.Webhook(), strings.NewReader(`{"msgtype":"text","text":{"content":"a"}}`))
+	if _, err := http.DefaultClient.Do(request); err == nil {
+		t.Error("request did not time out")
+	}
+}
+
+func TestServer_Upload(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	upload := func(mediaType string, data []byte) result {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, _ := writer.CreateFormFile("media", "report.txt")
+		_, _ = part.Write(data)
+		_ = writer.Close()
+		res, err := http.Post(server.UploadUrl(DefaultKey, mediaType), writer.FormDataContentType(), &body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		r := result{}
+		_ = json.NewDecoder(res.Body).Decode(&r)
+		return r
+	}
+	r := upload("file", []byte("hello world"))
+	if r.ErrCode != 0 || !strings.HasPrefix(r.MediaId, "file-") {
+		t.Errorf("upload = %+v", r)
+	}
+	if r := upload("file", []byte("hi")); r.ErrCode != InvalidMediaSizeErrCode {
+		t.Errorf("small upload = %+v", r)
+	}
+	if r := upload("image", []byte("hello world")); r.ErrCode != InvalidParameterErrCode {
+		t.Errorf("image upload = %+v", r)
+	}
+	uploads := server.Uploads()
+	if len(uploads) != 3 || uploads[0].Filename != "report.txt" || string(uploads[0].Data) != "hello world" || uploads[0].MediaId != r.MediaId {
+		t.Errorf("uploads = %+v", uploads)
+	}
+	if r := post(t, server.Webhook(), `{"msgtype":"file","file":{"media_id":"`+r.MediaId+`"}}`); r.ErrCode != 0 {
+		t.Errorf("file = %+v", r)
+	}
+}
@@ -0,0 +1,228 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrSchedulerStopped 调度器已停止
+var ErrSchedulerStopped = errors.New("work_weixin_robot: scheduler stopped")
+
+// MissedRunPolicy 错过执行时间(如进程挂起、时钟跳变)时的处理策略
+type MissedRunPolicy int
+
+const (
+	// MissedRunOnce 补发一次
+	MissedRunOnce MissedRunPolicy = iota
+	// MissedRunSkip 跳过，等待下一次执行
+	MissedRunSkip
+	// MissedRunAll 每次错过的执行都补发
+	MissedRunAll
+)
+
+// SchedulerOption Scheduler 配置项
+type SchedulerOption func(*Scheduler)
+
+// WithSchedulerClock 时钟，默认 SystemClock
+func WithSchedulerClock(clock Clock) SchedulerOption {
+	return func(scheduler *Scheduler) {
+		scheduler.clock = clock
+	}
+}
+
+// WithSchedulerLocation cron 表达式的时区，默认 time.Local
+func WithSchedulerLocation(loc *time.Location) SchedulerOption {
+	return func(scheduler *Scheduler) {
+		scheduler.loc = loc
+	}
+}
+
+// WithMissedRunPolicy 错过执行时间的处理策略，默认 MissedRunOnce；
+// 触发时间晚于计划时间超过 tolerance 即视为错过，默认 1 分钟
+func WithMissedRunPolicy(policy MissedRunPolicy, tolerance time.Duration) SchedulerOption {
+	return func(scheduler *Scheduler) {
+		scheduler.policy = policy
+		scheduler.tolerance = tolerance
+	}
+}
+
+// WithSchedulerErrorHandler 发送失败时的回调
+func WithSchedulerErrorHandler(handler func(id string, err error)) SchedulerOption {
+	return func(scheduler *Scheduler) {
+		scheduler.onError = handler
+	}
+}
+
+// scheduledJob 计划任务
+type scheduledJob struct {
+	id       string
+	message  Message
+	cron     *CronSchedule
+	next     time.Time
+	timer    Timer
+	canceled bool
+}
+
+// Scheduler 延迟及定时(cron)发送消息
+type Scheduler struct {
+	sender    Sender
+	clock     Clock
+	loc       *time.Location
+	policy    MissedRunPolicy
+	tolerance time.Duration
+	onError   func(id string, err error)
+
+	mu      sync.Mutex
+	seq     int
+	jobs    map[string]*scheduledJob
+	stopped bool
+}
+
+// NewScheduler create Scheduler
+func NewScheduler(sender Sender, opts ...SchedulerOption) *Scheduler {
+	scheduler := &Scheduler{
+		sender:    sender,
+		clock:     SystemClock,
+		loc:       time.Local,
+		policy:    MissedRunOnce,
+		tolerance: time.Minute,
+		onError:   func(string, error) {},
+		jobs:      map[string]*scheduledJob{},
+	}
+	for _, opt := range opts {
+		opt(scheduler)
+	}
+	return scheduler
+}
+
+// After 延迟 delay 后发送，返回任务 ID
+func (scheduler *Scheduler) After(delay time.Duration, message Message) (string, error) {
+	return scheduler.At(scheduler.clock.Now().Add(delay), message)
+}
+
+// At 在 t 时发送，返回任务 ID
+func (scheduler *Scheduler) At(t time.Time, message Message) (string, error) {
+	return scheduler.add(&scheduledJob{message: message, next: t})
+}
+
+// Cron 按 cron 表达式定时发送，返回任务 ID
+func (scheduler *Scheduler) Cron(spec string, message Message) (string, error) {
+	cron, err := ParseCron(spec)
+	if err != nil {
+		return "", err
+	}
+	next := cron.Next(scheduler.clock.Now().In(scheduler.loc))
+	if next.IsZero() {
+		return "", fmt.Errorf("cron %q: no matching time", spec)
+	}
+	return scheduler.add(&scheduledJob{message: message, cron: cron, next: next})
+}
+
+// Cancel 取消任务，任务不存在时返回 false
+func (scheduler *Scheduler) Cancel(id string) bool {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	job, ok := scheduler.jobs[id]
+	if !ok {
+		return false
+	}
+	job.canceled = true
+	job.timer.Stop()
+	delete(scheduler.jobs, id)
+	return true
+}
+
+// Jobs 未执行完成的任务 ID 及下一次执行时间
+func (scheduler *Scheduler) Jobs() map[string]time.Time {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	jobs := make(map[string]time.Time, len(scheduler.jobs))
+	for id, job := range scheduler.jobs {
+		jobs[id] = job.next
+	}
+	return jobs
+}
+
+// Stop 取消所有任务，之后无法再添加任务
+func (scheduler *Scheduler) Stop() {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	scheduler.stopped = true
+	for id, job := range scheduler.jobs {
+		job.canceled = true
+		job.timer.Stop()
+		delete(scheduler.jobs, id)
+	}
+}
+
+func (scheduler *Scheduler) add(job *scheduledJob) (string, error) {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	if scheduler.stopped {
+		return "", ErrSchedulerStopped
+	}
+	scheduler.seq++
+	job.id = fmt.Sprintf("job-%d", scheduler.seq)
+	scheduler.jobs[job.id] = job
+	scheduler.schedule(job)
+	return job.id, nil
+}
+
+// schedule 设置定时器，需持有锁
+func (scheduler *Scheduler) schedule(job *scheduledJob) {
+	delay := job.next.Sub(scheduler.clock.Now())
+	if delay < 0 {
+		delay = 0
+	}
+	job.timer = scheduler.clock.AfterFunc(delay, func() {
+		scheduler.run(job)
+	})
+}
+
+// run 执行任务并计算下一次执行时间
+func (scheduler *Scheduler) run(job *scheduledJob) {
+	scheduler.mu.Lock()
+	if job.canceled {
+		scheduler.mu.Unlock()
+		return
+	}
+	now := scheduler.clock.Now()
+	runs := 1
+	if now.Sub(job.next) > scheduler.tolerance {
+		switch scheduler.policy {
+		case MissedRunSkip:
+			runs = 0
+		case MissedRunAll:
+			if job.cron != nil {
+				for next := job.cron.Next(job.next); !next.IsZero() && !next.After(now); next = job.cron.Next(next) {
+					runs++
+				}
+			}
+		}
+	}
+	if job.cron != nil {
+		job.next = job.cron.Next(now.In(scheduler.loc))
+	}
+	if job.cron == nil || job.next.IsZero() {
+		delete(scheduler.jobs, job.id)
+	} else {
+		scheduler.schedule(job)
+	}
+	scheduler.mu.Unlock()
+	for i := 0; i < runs; i++ {
+		scheduler.send(job)
+	}
+}
+
+func (scheduler *Scheduler) send(job *scheduledJob) {
+	res, err := scheduler.sender.Send(context.Background(), "", job.message)
+	if err == nil && !res.IsSuccess() {
+		err = fmt.Errorf("send message error: code: %d  msg: %s", res.ErrCode, res.ErrMsg)
+	}
+	if err != nil {
+		scheduler.onError(job.id, err)
+	}
+}
@@ -0,0 +1,85 @@
+package work_weixin_robot
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestScheduler_After(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	scheduler := NewScheduler(NewRobotClientByWebHook(server.Webhook()), WithSchedulerClock(clock))
+
+	if _, err := scheduler.After(time.Hour, NewTextMessage("deploy freeze starts now")); err != nil {
+		t.Fatal(err)
+	}
+	id, _ := scheduler.After(30*time.Minute, NewTextMessage("canceled"))
+	if !scheduler.Cancel(id) || scheduler.Cancel(id) {
+		t.Error("cancel failed")
+	}
+	clock.Advance(59 * time.Minute)
+	if len(server.Messages()) != 0 {
+		t.Fatal("sent too early")
+	}
+	clock.Advance(time.Minute)
+	if contents := server.Contents(); !reflect.DeepEqual(contents, []string{"deploy freeze starts now"}) {
+		t.Errorf("contents = %q", contents)
+	}
+	if jobs := scheduler.Jobs(); len(jobs) != 0 {
+		t.Errorf("jobs = %v", jobs)
+	}
+}
+
+func TestScheduler_Cron(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	loc := time.FixedZone("CST", 8*3600)
+	clock := newFakeClock(time.Date(2022, 8, 15, 0, 0, 0, 0, loc)) // Monday
+	scheduler := NewScheduler(NewRobotClientByWebHook(server.Webhook()), WithSchedulerClock(clock), WithSchedulerLocation(loc))
+
+	id, err := scheduler.Cron("30 9 * * 1-5", NewTextMessage("standup"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := scheduler.Jobs()[id]; !next.Equal(time.Date(2022, 8, 15, 9, 30, 0, 0, loc)) {
+		t.Errorf("next = %s", next)
+	}
+	clock.Advance(7 * 24 * time.Hour)
+	if n := len(server.Messages()); n != 5 {
+		t.Errorf("received = %d", n)
+	}
+	scheduler.Stop()
+	clock.Advance(7 * 24 * time.Hour)
+	if n := len(server.Messages()); n != 5 {
+		t.Errorf("received after stop = %d", n)
+	}
+	if _, err := scheduler.After(time.Minute, NewTextMessage("stopped")); err != ErrSchedulerStopped {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := NewScheduler(nil).Cron("bad", NewTextMessage("bad")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestScheduler_MissedRunPolicy(t *testing.T) {
+	for policy, want := range map[MissedRunPolicy]int{MissedRunSkip: 0, MissedRunOnce: 1, MissedRunAll: 4} {
+		server := robottest.NewServer()
+		defer server.Close()
+		clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+		scheduler := NewScheduler(NewRobotClientByWebHook(server.Webhook()),
+			WithSchedulerClock(clock), WithSchedulerLocation(time.UTC), WithMissedRunPolicy(policy, time.Minute))
+
+		id, _ := scheduler.Cron("0 * * * *", NewTextMessage("hourly"))
+		clock.Jump(4*time.Hour + 30*time.Minute)
+		if n := len(server.Messages()); n != want {
+			t.Errorf("policy %d: received = %d, want %d", policy, n, want)
+		}
+		if next := scheduler.Jobs()[id]; !next.Equal(time.Date(2022, 8, 15, 15, 0, 0, 0, time.UTC)) {
+			t.Errorf("policy %d: next = %s", policy, next)
+		}
+	}
+}
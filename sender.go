@@ -0,0 +1,77 @@
+package work_weixin_robot
+
+import "context"
+
+// Sender 发送消息到 url，url 为空时发送到默认地址。
+// WorkWeixinRobotClient、RecordingSender、Aggregator 均实现 Sender，可通过 WrapSender 以拦截器装饰
+type Sender interface {
+	Send(ctx context.Context, url string, message Message) (*RobotResponse, error)
+}
+
+// SenderFunc 函数形式的 Sender
+type SenderFunc func(ctx context.Context, url string, message Message) (*RobotResponse, error)
+
+// Send Sender
+func (f SenderFunc) Send(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+	return f(ctx, url, message)
+}
+
+// Send Sender, url 为空时发送到 WorkWeixinRobotClient.Webhook
+func (client *WorkWeixinRobotClient) Send(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+	if url == "" {
+		url = client.Webhook
+	}
+	return client.SendMessageByUrlContext(ctx, url, message)
+}
+
+// defaultWebhook Sender, 默认地址
+func (client *WorkWeixinRobotClient) defaultWebhook() string {
+	return client.Webhook
+}
+
+// delegatingSender 装饰 inner 的 Sender，默认地址同 inner
+type delegatingSender struct {
+	send  SenderFunc
+	inner Sender
+}
+
+// Send Sender
+func (sender *delegatingSender) Send(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+	return sender.send(ctx, url, message)
+}
+
+func (sender *delegatingSender) defaultWebhook() string {
+	return defaultWebhook(sender.inner)
+}
+
+// WrapSender 以拦截器装饰 sender，先添加的拦截器位于外层，url 为空时 RobotRequest.Url 为空
+func WrapSender(sender Sender, middlewares ...Middleware) Sender {
+	handler := chain(func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+		message := request.Message
+		if message == nil {
+			message = RawMessage(request.Body)
+		}
+		return sender.Send(ctx, request.Url, message)
+	}, middlewares...)
+	return &delegatingSender{inner: sender, send: func(ctx context.Context, url string, message Message) (*RobotResponse, error) {
+		request, err := newRobotRequest(url, message)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, request)
+	}}
+}
+
+// webhookSender 可以得到默认地址的 Sender
+type webhookSender interface {
+	defaultWebhook() string
+}
+
+// defaultWebhook sender 的默认地址，WorkWeixinRobotClient 及以 WrapSender、Aggregator、Policy.Sender 装饰的
+// WorkWeixinRobotClient 返回其 Webhook，否则返回空字符串(即 sender 的默认地址)，用于按 webhook 区分的配置及指标
+func defaultWebhook(sender Sender) string {
+	if sender, ok := sender.(webhookSender); ok {
+		return sender.defaultWebhook()
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWrapSender(t *testing.T) {
+	recorder := NewRecordingSender()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	dedup := NewDeduplicator(time.Minute, WithDedupClock(clock))
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+				order = append(order, name)
+				return next(ctx, request)
+			}
+		}
+	}
+	sender := WrapSender(recorder, trace("outer"), dedup.Middleware, trace("inner"))
+
+	ctx := context.Background()
+	if _, err := sender.Send(ctx, "", NewTextMessage("disk full")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sender.Send(ctx, "", NewTextMessage("disk full")); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("err = %v", err)
+	}
+	if !reflect.DeepEqual(order, []string{"outer", "inner", "outer"}) {
+		t.Errorf("order = %v", order)
+	}
+	if recordings := recorder.Recordings(); len(recordings) != 1 {
+		t.Errorf("recordings = %d", len(recordings))
+	}
+}
+
+func TestAggregator_Sender(t *testing.T) {
+	recorder := NewRecordingSender()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	var sender Sender = NewAggregator(recorder, time.Minute, WithAggregatorClock(clock))
+	policy := NewPolicy(sender)
+
+	ctx := context.Background()
+	if _, err := policy.Sender(SeverityInfo).Send(ctx, "", NewTextMessage("api deployed")); !errors.Is(err, ErrAggregated) {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := sender.Send(ctx, "", NewTextMessage("web deployed")); !errors.Is(err, ErrAggregated) {
+		t.Errorf("err = %v", err)
+	}
+	if res, err := sender.Send(ctx, "", NewFileMessage("3a8asd892asd8asd")); err != nil || !res.IsSuccess() {
+		t.Errorf("file = %v, %v", res, err)
+	}
+	clock.Advance(time.Minute)
+
+	recordings := recorder.Recordings()
+	if len(recordings) != 2 {
+		t.Fatalf("recordings = %d", len(recordings))
+	}
+	if _, ok := recordings[0].Message.(*FileMessage); !ok {
+		t.Errorf("first = %T", recordings[0].Message)
+	}
+	if markdown, ok := recordings[1].Message.(*MarkdownMessage); !ok || markdown.Content != "api deployed\nweb deployed" {
+		t.Errorf("digest = %+v", recordings[1].Message)
+	}
+}
+
+func TestWrapSender_Accepted(t *testing.T) {
+	recorder := NewRecordingSender()
+	clock := newFakeClock(time.Date(2022, 8, 15, 10, 0, 0, 0, time.UTC))
+	metrics := NewPrometheusMetrics("")
+	logger := &testLogger{}
+	aggregator := NewAggregator(recorder, time.Minute, WithAggregatorClock(clock))
+	sender := WrapSender(aggregator, LoggingMiddleware(logger, DefaultLogLevels), MetricsMiddleware(metrics))
+
+	_, err := sender.Send(context.Background(), "", NewTextMessage("api deployed"))
+	if !errors.Is(err, ErrAggregated) || !errors.Is(err, ErrAccepted) || errors.Is(err, ErrDropped) {
+		t.Errorf("err = %v", err)
+	}
+	if !errors.Is(ErrDeferred, ErrAccepted) {
+		t.Error("ErrDeferred is not ErrAccepted")
+	}
+	for _, record := range logger.records {
+		if record.level == "error" {
+			t.Errorf("record = %+v", record)
+		}
+	}
+	if last := logger.records[len(logger.records)-1]; last.msg != "work weixin robot message accepted" {
+		t.Errorf("record = %+v", last)
+	}
+	var body strings.Builder
+	_, _ = metrics.WriteTo(&body)
+	if strings.Contains(body.String(), "messages_sent_total{") {
+		t.Errorf("accepted message counted:\n%s", body.String())
+	}
+}
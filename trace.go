@@ -0,0 +1,87 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// SendSpanName 发送消息的 span 名称
+const SendSpanName = "work_weixin_robot.send"
+
+// Attribute span 属性
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span 链路追踪 span
+type Span interface {
+	// SetAttributes 设置属性
+	SetAttributes(attributes ...Attribute)
+	// RecordError 记录错误
+	RecordError(err error)
+	// End 结束 span
+	End()
+}
+
+// Tracer 链路追踪，可适配 OpenTelemetry 等实现
+type Tracer interface {
+	// Start 从 ctx 创建 span
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// TracingMiddleware 为每次发送创建 span 的拦截器，消息被汇总、延迟或丢弃时不记录错误，以 not_sent 属性记录原因
+func TracingMiddleware(tracer Tracer) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			ctx, span := tracer.Start(ctx, SendSpanName)
+			defer span.End()
+			attributes := []Attribute{
+				{Key: "webhook", Value: RedactWebhook(request.Url)},
+				{Key: "msgtype", Value: string(request.MsgType())},
+				{Key: "payload_size", Value: len(request.Body)},
+			}
+			if cardType := request.cardType(); cardType != "" {
+				attributes = append(attributes, Attribute{Key: "card_type", Value: cardType})
+			}
+			span.SetAttributes(attributes...)
+			res, err := next(ctx, request)
+			span.SetAttributes(Attribute{Key: "retries", Value: request.Retries})
+			if notSent(err) {
+				span.SetAttributes(Attribute{Key: "not_sent", Value: err.Error()})
+				return res, err
+			}
+			if err != nil {
+				span.RecordError(err)
+				return res, err
+			}
+			if res != nil {
+				span.SetAttributes(Attribute{Key: "errcode", Value: res.ErrCode})
+			}
+			return res, err
+		}
+	}
+}
+
+// WithTracer 为每次发送创建 span
+func WithTracer(tracer Tracer) ClientOption {
+	return WithMiddleware(TracingMiddleware(tracer))
+}
+
+// cardType 模版卡片类型，非模版卡片返回空
+func (request *RobotRequest) cardType() string {
+	if message, ok := request.Message.(CardBaseMessage); ok {
+		cardType, _ := message.CardMessageMap()["card_type"].(string)
+		return cardType
+	}
+	if request.Message != nil {
+		return ""
+	}
+	body := struct {
+		TemplateCard struct {
+			CardType string `json:"card_type"`
+		} `json:"template_card"`
+	}{}
+	_ = json.Unmarshal(request.Body, &body)
+	return body.TemplateCard.CardType
+}
@@ -0,0 +1,132 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	name       string
+	parent     interface{}
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (span *testSpan) SetAttributes(attributes ...Attribute) {
+	for _, attribute := range attributes {
+		span.attributes[attribute.Key] = attribute.Value
+	}
+}
+
+func (span *testSpan) RecordError(err error) {
+	span.err = err
+}
+
+func (span *testSpan) End() {
+	span.ended = true
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (tracer *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, parent: ctx.Value(testSpanKey{}), attributes: map[string]interface{}{}}
+	tracer.spans = append(tracer.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func TestWithTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	client := NewRobotClientByWebHook(server.URL+"?key=693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa", WithTracer(tracer))
+	message := NewCardTextNoticeMessage(
+		NewCardMainTitle().SetTitle("trace"),
+		NewCardAction(ClickUrl).SetUrl("https://work.weixin.qq.com/?from=openApi"),
+	)
+	ctx := context.WithValue(context.Background(), testSpanKey{}, "parent")
+	if _, err := client.SendMessageContext(ctx, message); err != nil {
+		t.Fatal("send message error", err)
+	}
+	if len(tracer.spans) != 1 {
+		t.Fatalf("spans = %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != SendSpanName || span.parent != "parent" || !span.ended {
+		t.Errorf("unexpected span: %+v", span)
+	}
+	want := map[string]interface{}{
+		"webhook":   server.URL + "?key=693a***",
+		"msgtype":   "template_card",
+		"card_type": "text_notice",
+		"errcode":   0,
+		"retries":   0,
+	}
+	for key, value := range want {
+		if span.attributes[key] != value {
+			t.Errorf("attribute %s = %v, want %v", key, span.attributes[key], value)
+		}
+	}
+	if size, _ := span.attributes["payload_size"].(int); size == 0 {
+		t.Error("payload_size not recorded")
+	}
+}
+
+func TestWithTracerError(t *testing.T) {
+	tracer := &testTracer{}
+	client := NewRobotClientByWebHook("http://127.0.0.1:0", WithTracer(tracer))
+	if _, err := client.SendMessageStr(`{"msgtype":"template_card","template_card":{"card_type":"news_notice"}}`); err == nil {
+		t.Fatal("expected error")
+	}
+	span := tracer.spans[0]
+	if span.err == nil || span.attributes["card_type"] != "news_notice" {
+		t.Errorf("unexpected span: %+v", span)
+	}
+}
+
+func TestWithTracerNilResponse(t *testing.T) {
+	skip := func(next Handler) Handler {
+		return func(ctx context.Context, request *RobotRequest) (*RobotResponse, error) {
+			return nil, nil
+		}
+	}
+	tracer := &testTracer{}
+	client := NewRobotClientByWebHook("http://127.0.0.1:0", WithTracer(tracer), WithMiddleware(skip))
+	if res, err := client.SendMessage(NewTextMessage("skip")); res != nil || err != nil {
+		t.Fatalf("res = %+v, err = %v", res, err)
+	}
+	if len(tracer.spans) != 1 || !tracer.spans[0].ended {
+		t.Fatalf("spans = %+v", tracer.spans)
+	}
+	if _, ok := tracer.spans[0].attributes["errcode"]; ok {
+		t.Errorf("attributes = %v", tracer.spans[0].attributes)
+	}
+}
+
+func TestWithTracerAccepted(t *testing.T) {
+	clock := newFakeClock(time.Date(2022, 8, 15, 23, 0, 0, 0, time.UTC))
+	client := NewRobotClientByWebHook("http://127.0.0.1:0")
+	quiet, _ := NewQuietHours("22:00", "08:00", time.UTC)
+	policy := NewPolicy(client, WithPolicyClock(clock), WithQuietHours("", quiet))
+	for _, next := range []Sender{NewAggregator(client, time.Hour, WithAggregatorClock(clock)), policy.Sender(SeverityInfo)} {
+		tracer := &testTracer{}
+		sender := WrapSender(next, TracingMiddleware(tracer))
+		if _, err := sender.Send(context.Background(), "", NewTextMessage("disk full")); !errors.Is(err, ErrAccepted) {
+			t.Fatalf("%T: err = %v", next, err)
+		}
+		if span := tracer.spans[0]; span.err != nil || span.attributes["not_sent"] == nil {
+			t.Errorf("%T: span = %+v", next, span)
+		}
+	}
+}
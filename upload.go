@@ -0,0 +1,115 @@
+package work_weixin_robot
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/group-robot/work-weixin-robot/robotschema"
+)
+
+// MediaType upload_media 文件类型
+type MediaType string
+
+const (
+	// FileMediaType 普通文件
+	FileMediaType MediaType = "file"
+	// VoiceMediaType 语音，仅支持 AMR 格式
+	VoiceMediaType MediaType = "voice"
+)
+
+// UploadResponse upload_media 返回结果，media_id 三天内有效
+type UploadResponse struct {
+	RobotResponse
+	// Type 文件类型
+	Type MediaType `json:"type"`
+	// MediaId 用于发送 FileMessage 的 media_id
+	MediaId string `json:"media_id"`
+	// CreatedAt 上传时间戳
+	CreatedAt string `json:"created_at"`
+}
+
+// UploadMedia 上传文件到 webhook 对应的 upload_media 接口
+func (client *WorkWeixinRobotClient) UploadMedia(mediaType MediaType, filename string, reader io.Reader) (*UploadResponse, error) {
+	return client.UploadMediaContext(context.Background(), mediaType, filename, reader)
+}
+
+// UploadMediaContext 上传文件 with context
+func (client *WorkWeixinRobotClient) UploadMediaContext(ctx context.Context, mediaType MediaType, filename string, reader io.Reader) (*UploadResponse, error) {
+	return client.UploadMediaByUrlContext(ctx, client.Webhook, mediaType, filename, reader)
+}
+
+// UploadMediaByUrlContext 上传文件到 webhook 对应的 upload_media 接口，webhook 为发送消息的地址；
+// 上传不经过中间件及重试，WithDryRun 时只校验文件大小并输出
+func (client *WorkWeixinRobotClient) UploadMediaByUrlContext(ctx context.Context, webhook string, mediaType MediaType, filename string, reader io.Reader) (*UploadResponse, error) {
+	uploadUrl, err := mediaUploadUrl(webhook, mediaType)
+	if err != nil {
+		return nil, err
+	}
+	if client.dryRun != nil {
+		return client.dryRun.upload(uploadUrl, mediaType, filename, reader)
+	}
+	resp, err := client.client.R().
+		SetContext(ctx).
+		ForceContentType("application/json").
+		SetFileReader("media", filename, reader).
+		SetResult(&UploadResponse{}).
+		Post(uploadUrl)
+	if err != nil {
+		return nil, redactError(err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("work_weixin_robot: unexpected http status: %s", resp.Status())
+	}
+	return resp.Result().(*UploadResponse), nil
+}
+
+// mediaUploadUrl 由发送消息的 webhook 地址得到 upload_media 地址
+func mediaUploadUrl(webhook string, mediaType MediaType) (string, error) {
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return "", redactError(err)
+	}
+	if !strings.HasSuffix(u.Path, "/send") {
+		return "", fmt.Errorf("not a webhook send url: %s", RedactWebhook(webhook))
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/send") + "/upload_media"
+	query := u.Query()
+	query.Set("type", string(mediaType))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// upload 输出上传的文件信息，返回以文件 md5 生成的 media_id
+func (dry *dryRun) upload(uploadUrl string, mediaType MediaType, filename string, reader io.Reader) (*UploadResponse, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	response := &UploadResponse{RobotResponse: RobotResponse{ErrCode: 0, ErrMsg: "ok"}, Type: mediaType}
+	switch {
+	case mediaType != FileMediaType && mediaType != VoiceMediaType:
+		response.RobotResponse = RobotResponse{ErrCode: robotschema.InvalidParameterErrCode, ErrMsg: "invalid media type"}
+	case len(data) < robotschema.MediaMinBytes || len(data) > robotschema.MediaMaxBytes:
+		response.RobotResponse = RobotResponse{ErrCode: robotschema.InvalidMediaSizeErrCode, ErrMsg: "invalid media size"}
+	default:
+		sum := md5.Sum(data)
+		response.MediaId = "dry-run-" + hex.EncodeToString(sum[:])
+	}
+
+	dry.mu.Lock()
+	defer dry.mu.Unlock()
+	_, err = fmt.Fprintf(dry.w, "POST %s\n%s %s (%s)\n", RedactWebhook(uploadUrl), mediaType, filename, formatBytes(len(data)))
+	if err == nil && !response.IsSuccess() {
+		_, err = fmt.Fprintf(dry.w, "--- errcode %d: %s ---\n", response.ErrCode, response.ErrMsg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
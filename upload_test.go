@@ -0,0 +1,77 @@
+package work_weixin_robot
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/group-robot/work-weixin-robot/robottest"
+)
+
+func TestWorkWeixinRobotClient_UploadMedia(t *testing.T) {
+	server := robottest.NewServer()
+	defer server.Close()
+	client := NewRobotClientByWebHook(server.Webhook())
+
+	res, err := client.UploadMedia(FileMediaType, "report.txt", strings.NewReader("disk usage report"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsSuccess() || res.Type != FileMediaType || res.MediaId == "" {
+		t.Fatalf("upload = %+v", res)
+	}
+	if res, err := client.SendMessage(NewFileMessage(res.MediaId)); err != nil || !res.IsSuccess() {
+		t.Errorf("send = %+v, %v", res, err)
+	}
+	uploads := server.Uploads()
+	if len(uploads) != 1 || uploads[0].Key != robottest.DefaultKey || uploads[0].Filename != "report.txt" || string(uploads[0].Data) != "disk usage report" {
+		t.Errorf("uploads = %+v", uploads)
+	}
+
+	if res, err := client.UploadMedia(FileMediaType, "empty.txt", strings.NewReader("")); err != nil || res.ErrCode != robottest.InvalidMediaSizeErrCode {
+		t.Errorf("empty upload = %+v, %v", res, err)
+	}
+}
+
+func TestMediaUploadUrl(t *testing.T) {
+	got, err := mediaUploadUrl(testWebhook, VoiceMediaType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://qyapi.weixin.qq.com/cgi-bin/webhook/upload_media?key=693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa&type=voice"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if _, err := mediaUploadUrl("https://example.com/hook?key=693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa", FileMediaType); err == nil || strings.Contains(err.Error(), "7xxx") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestWithDryRun_UploadMedia(t *testing.T) {
+	transport := &countTransport{}
+	var out bytes.Buffer
+	client := NewRobotClientByWebHook(testWebhook, WithTransport(transport), WithDryRun(&out))
+
+	res, err := client.UploadMedia(FileMediaType, "report.txt", strings.NewReader("disk usage report"))
+	if err != nil || !res.IsSuccess() || !strings.HasPrefix(res.MediaId, "dry-run-") {
+		t.Fatalf("upload = %+v, %v", res, err)
+	}
+	if transport.count != 0 {
+		t.Errorf("requests = %d", transport.count)
+	}
+	if want := "POST https://qyapi.weixin.qq.com/cgi-bin/webhook/upload_media?key=693a***&type=file\nfile report.txt (17B)\n"; out.String() != want {
+		t.Errorf("out = %q, want %q", out.String(), want)
+	}
+}
+
+func TestWorkWeixinRobotClient_UploadMediaStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	}))
+	defer server.Close()
+	client := NewRobotClientByWebHook(server.URL + robottest.SendPath + "?key=test")
+	if _, err := client.UploadMedia(FileMediaType, "report.txt", strings.NewReader("disk usage report")); err == nil || !strings.Contains(err.Error(), "502") {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+// Package wxbizmsgcrypt 企业微信回调消息加解密，与官方 WXBizMsgCrypt 示例兼容：
+// 签名为 sha1(sort(token, timestamp, nonce, encrypt))，
+// 明文 random(16) + msg_len(4, 网络字节序) + msg + receiveid 经 PKCS#7(块大小 32) 填充后以 AES-256-CBC 加密，
+// 密钥为 base64(EncodingAESKey + "=")，IV 为密钥前 16 字节
+package wxbizmsgcrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrInvalidAESKey EncodingAESKey 不是 43 位 base64 字符串
+	ErrInvalidAESKey = errors.New("wxbizmsgcrypt: invalid EncodingAESKey")
+	// ErrInvalidSignature 签名校验失败
+	ErrInvalidSignature = errors.New("wxbizmsgcrypt: invalid signature")
+	// ErrInvalidCiphertext 密文格式、填充或长度错误
+	ErrInvalidCiphertext = errors.New("wxbizmsgcrypt: invalid ciphertext")
+	// ErrInvalidReceiveId receiveid 不匹配
+	ErrInvalidReceiveId = errors.New("wxbizmsgcrypt: invalid receive id")
+)
+
+const (
+	// BlockSize PKCS#7 填充的块大小
+	BlockSize = 32
+	// RandomSize 明文随机前缀的字节数
+	RandomSize = 16
+)
+
+// MsgCrypt 消息加解密
+type MsgCrypt struct {
+	token     string
+	key       []byte
+	receiveId string
+}
+
+// New create MsgCrypt, receiveId 为空时解密不校验 receiveid，加密时 receiveid 为空
+func New(token, encodingAESKey, receiveId string) (*MsgCrypt, error) {
+	if len(encodingAESKey) != 43 {
+		return nil, ErrInvalidAESKey
+	}
+	key, err := base64.StdEncoding.DecodeString(encodingAESKey + "=")
+	if err != nil || len(key) != 32 {
+		return nil, ErrInvalidAESKey
+	}
+	return &MsgCrypt{token: token, key: key, receiveId: receiveId}, nil
+}
+
+// WithReceiveId 复制 MsgCrypt 并设置 receiveid
+func (crypt *MsgCrypt) WithReceiveId(receiveId string) *MsgCrypt {
+	copied := *crypt
+	copied.receiveId = receiveId
+	return &copied
+}
+
+// ReceiveId receiveid
+func (crypt *MsgCrypt) ReceiveId() string {
+	return crypt.receiveId
+}
+
+// Signature sha1(sort(token, timestamp, nonce, encrypt)) 的十六进制小写字符串
+func (crypt *MsgCrypt) Signature(timestamp, nonce, encrypt string) string {
+	values := []string{crypt.token, timestamp, nonce, encrypt}
+	sort.Strings(values)
+	sum := sha1.Sum([]byte(strings.Join(values, "")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify 校验签名
+func (crypt *MsgCrypt) Verify(signature, timestamp, nonce, encrypt string) error {
+	expected := crypt.Signature(timestamp, nonce, encrypt)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) != 1 {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Decrypt 解密 base64 密文，返回 msg
+func (crypt *MsgCrypt) Decrypt(encrypt string) ([]byte, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypt)
+	if err != nil || len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, ErrInvalidCiphertext
+	}
+	block, err := aes.NewCipher(crypt.key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, crypt.key[:aes.BlockSize]).CryptBlocks(plaintext, ciphertext)
+	plaintext, err = unpad(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	if len(plaintext) < RandomSize+4 {
+		return nil, ErrInvalidCiphertext
+	}
+	size := binary.BigEndian.Uint32(plaintext[RandomSize : RandomSize+4])
+	content := plaintext[RandomSize+4:]
+	if uint64(size) > uint64(len(content)) {
+		return nil, ErrInvalidCiphertext
+	}
+	if crypt.receiveId != "" && string(content[size:]) != crypt.receiveId {
+		return nil, ErrInvalidReceiveId
+	}
+	return content[:size], nil
+}
+
+// Encrypt 以随机前缀加密 msg，返回 base64 密文
+func (crypt *MsgCrypt) Encrypt(msg []byte) (string, error) {
+	random := make([]byte, RandomSize)
+	if _, err := io.ReadFull(rand.Reader, random); err != nil {
+		return "", err
+	}
+	return crypt.EncryptWithRandom(random, msg)
+}
+
+// EncryptWithRandom 以指定的 16 字节随机前缀加密 msg，用于生成固定的测试数据
+func (crypt *MsgCrypt) EncryptWithRandom(random, msg []byte) (string, error) {
+	if len(random) != RandomSize {
+		return "", errors.New("wxbizmsgcrypt: random prefix must be 16 bytes")
+	}
+	var buf bytes.Buffer
+	buf.Write(random)
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, uint32(len(msg)))
+	buf.Write(size)
+	buf.Write(msg)
+	buf.WriteString(crypt.receiveId)
+	plaintext := pad(buf.Bytes())
+	block, err := aes.NewCipher(crypt.key)
+	if err != nil {
+		return "", err
+	}
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, crypt.key[:aes.BlockSize]).CryptBlocks(ciphertext, plaintext)
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// VerifyURL 校验回调 URL，返回解密后的 echostr
+func (crypt *MsgCrypt) VerifyURL(signature, timestamp, nonce, echostr string) ([]byte, error) {
+	return crypt.DecryptMsg(signature, timestamp, nonce, echostr)
+}
+
+// DecryptMsg 校验签名并解密
+func (crypt *MsgCrypt) DecryptMsg(signature, timestamp, nonce, encrypt string) ([]byte, error) {
+	if err := crypt.Verify(signature, timestamp, nonce, encrypt); err != nil {
+		return nil, err
+	}
+	return crypt.Decrypt(encrypt)
+}
+
+// EncryptMsg 加密 msg 并签名，返回密文及签名
+func (crypt *MsgCrypt) EncryptMsg(msg []byte, timestamp, nonce string) (encrypt, signature string, err error) {
+	encrypt, err = crypt.Encrypt(msg)
+	if err != nil {
+		return "", "", err
+	}
+	return encrypt, crypt.Signature(timestamp, nonce, encrypt), nil
+}
+
+// pad PKCS#7 填充到 BlockSize 的整数倍，已对齐时填充一个完整块
+func pad(data []byte) []byte {
+	n := BlockSize - len(data)%BlockSize
+	return append(data, bytes.Repeat([]byte{byte(n)}, n)...)
+}
+
+// unpad 去除 PKCS#7 填充并校验填充字节
+func unpad(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, ErrInvalidCiphertext
+	}
+	n := int(data[len(data)-1])
+	if n < 1 || n > BlockSize || n > len(data) {
+		return nil, ErrInvalidCiphertext
+	}
+	for _, b := range data[len(data)-n:] {
+		if int(b) != n {
+			return nil, ErrInvalidCiphertext
+		}
+	}
+	return data[:len(data)-n], nil
+}
@@ -0,0 +1,185 @@
+package wxbizmsgcrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+const (
+	testToken          = "QDG6eK"
+	testEncodingAESKey = "jWmYm7qr5nMoAUwZRjGtBxmz3KA1tkAj3ykkR6q2B2C"
+	testReceiveId      = "wx5823bf96d3bd56c7"
+	testTimestamp      = "1409659589"
+	testNonce          = "263014780"
+)
+
+var testRandom = []byte("aaaabbbbccccdddd")
+
+func newTestCrypt(t *testing.T) *MsgCrypt {
+	crypt, err := New(testToken, testEncodingAESKey, testReceiveId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crypt
+}
+
+// seal 以 AES-256-CBC 直接加密 plaintext(不填充)，构造非法明文
+func seal(t *testing.T, plaintext []byte) string {
+	key, _ := base64.StdEncoding.DecodeString(testEncodingAESKey + "=")
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, key[:aes.BlockSize]).CryptBlocks(ciphertext, plaintext)
+	return base64.StdEncoding.EncodeToString(ciphertext)
+}
+
+// frame random + msg_len + msg + receiveid
+func frame(size uint32, msg, receiveId string) []byte {
+	var buf bytes.Buffer
+	buf.Write(testRandom)
+	_ = binary.Write(&buf, binary.BigEndian, size)
+	buf.WriteString(msg)
+	buf.WriteString(receiveId)
+	return buf.Bytes()
+}
+
+// TestMsgCrypt_VerifyURL 官方示例中的 URL 校验数据
+func TestMsgCrypt_VerifyURL(t *testing.T) {
+	crypt := newTestCrypt(t)
+	echostr := "P9nAzCzyDtyTWESHep1vC5X9xho/qYX3Zpb4yKa9SKld1DsH3Iyt3tP3zNdtp+4RPcs8TgAE7OaBO+FZXvnaqQ=="
+	plaintext, err := crypt.VerifyURL("5c45ff5e21c57e6ad56bac8758b79b1d9ac89fd3", "1409659589", "263014780", echostr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != "1616140317555161061" {
+		t.Errorf("echostr = %q", plaintext)
+	}
+	if _, err := crypt.VerifyURL("5c45ff5e21c57e6ad56bac8758b79b1d9ac89fd3", "1409659590", "263014780", echostr); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("err = %v", err)
+	}
+}
+
+// TestMsgCrypt_Vectors 由 openssl enc -aes-256-cbc -nopad 独立生成，random 为 aaaabbbbccccdddd
+func TestMsgCrypt_Vectors(t *testing.T) {
+	tests := []struct {
+		msg       string
+		pad       int
+		encrypt   string
+		signature string
+	}{
+		{"", 26, "ZA4HuQypHxnRrA+A6SOHGL4m235ZCN1+Jv+aZ2kyu/rN3XE1k18dKRFj4YPZDobZHzL5ah1o0U98CpJT0i4GWQ==", "ea4b3b44b2610f6eb34222324a49f99263133174"},
+		{"hello", 21, "ZA4HuQypHxnRrA+A6SOHGNOB7gDkeg+o+hG8ENTXAny8CPEgaO1IvTTlbHRbpeGQQVVrc60WYA641YjLK+BMxg==", "1f83b21fd3292935457c214dbee080e888ba072b"},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaa", 1, "ZA4HuQypHxnRrA+A6SOHGINB2UwZi0E2h6TVI/sAbsG/tIHd1/4gGC9hi2f01mS1rhnkEC3kGBHD44uxGWLIdA==", "70324856a8aa1d12a7eddbc3f1c1ec4e5d5712d3"},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaa", 32, "ZA4HuQypHxnRrA+A6SOHGIF/GTMamRn9ejZtMJ7Pnd56yEOhkcKOFjBQ8RB5GFGr6rjBUXYLsmlHM9AZhvxNmxwNMebk9TPJc4ui7LpdPqtf5+qSDxcrTM7uGIk1tQuP", "d3ed20102f497947969f8b4d945eeb17a1bf350d"},
+		{"<xml><MsgType>text</MsgType></xml>", 24, "ZA4HuQypHxnRrA+A6SOHGF1JWW1kOqc0sVJE0gb9p0fMPhLr5vATGYweRscX0yZVxoo9i8bNTyytkJFP2j30HvC2anBN7c+PevHHb6f5ETlzwN1YfRxqY6nMpgUH/wpB", "b2b0a562490b76a3c982da6190aed85c19da3dd9"},
+		{"机器人回调", 11, "ZA4HuQypHxnRrA+A6SOHGA4CCD+g+LSIYkbS7I7a7OU2S9YwzGnWfm849J9fu+r0Ej2dkHq3MfjC49q7p1FIGg==", "98aeefa87a002f04fa402eb3c1e7775756f85237"},
+	}
+	crypt := newTestCrypt(t)
+	for _, tt := range tests {
+		encrypt, err := crypt.EncryptWithRandom(testRandom, []byte(tt.msg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if encrypt != tt.encrypt {
+			t.Errorf("encrypt(%q) = %s, want %s", tt.msg, encrypt, tt.encrypt)
+		}
+		if padded := len(frame(0, tt.msg, testReceiveId)) + tt.pad; padded%BlockSize != 0 {
+			t.Errorf("pad(%q) = %d is not aligned", tt.msg, tt.pad)
+		}
+		if signature := crypt.Signature(testTimestamp, testNonce, tt.encrypt); signature != tt.signature {
+			t.Errorf("signature(%q) = %s, want %s", tt.msg, signature, tt.signature)
+		}
+		msg, err := crypt.DecryptMsg(tt.signature, testTimestamp, testNonce, tt.encrypt)
+		if err != nil {
+			t.Errorf("decrypt(%q) error: %v", tt.msg, err)
+			continue
+		}
+		if string(msg) != tt.msg {
+			t.Errorf("decrypt = %q, want %q", msg, tt.msg)
+		}
+	}
+}
+
+func TestMsgCrypt_RoundTrip(t *testing.T) {
+	crypt := newTestCrypt(t)
+	for size := 0; size <= 3*BlockSize; size++ {
+		msg := bytes.Repeat([]byte{'x'}, size)
+		encrypt, signature, err := crypt.EncryptMsg(msg, testTimestamp, testNonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decrypted, err := crypt.DecryptMsg(signature, testTimestamp, testNonce, encrypt)
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if !bytes.Equal(decrypted, msg) {
+			t.Errorf("size %d: decrypt = %q", size, decrypted)
+		}
+	}
+	first, _ := crypt.Encrypt([]byte("hello"))
+	second, _ := crypt.Encrypt([]byte("hello"))
+	if first == second {
+		t.Error("random prefix is not random")
+	}
+}
+
+func TestMsgCrypt_ReceiveId(t *testing.T) {
+	crypt := newTestCrypt(t)
+	other := crypt.WithReceiveId("other")
+	if crypt.ReceiveId() != testReceiveId || other.ReceiveId() != "other" {
+		t.Errorf("receive id = %s, %s", crypt.ReceiveId(), other.ReceiveId())
+	}
+	encrypt, _ := other.Encrypt([]byte("hello"))
+	if _, err := crypt.Decrypt(encrypt); !errors.Is(err, ErrInvalidReceiveId) {
+		t.Errorf("err = %v", err)
+	}
+	msg, err := crypt.WithReceiveId("").Decrypt(encrypt)
+	if err != nil || string(msg) != "hello" {
+		t.Errorf("decrypt without receive id = %q, %v", msg, err)
+	}
+}
+
+func TestMsgCrypt_InvalidCiphertext(t *testing.T) {
+	crypt := newTestCrypt(t)
+	padded := func(data []byte, n int) []byte {
+		return append(data, bytes.Repeat([]byte{byte(n)}, n)...)
+	}
+	valid := frame(5, "hello", testReceiveId)
+	tests := map[string]string{
+		"not base64":          "not base64!",
+		"empty":               "",
+		"not block aligned":   base64.StdEncoding.EncodeToString([]byte("short")),
+		"zero padding":        seal(t, append(bytes.Repeat([]byte{'x'}, 31), 0)),
+		"padding over 32":     seal(t, append(bytes.Repeat([]byte{'x'}, 47), 33)),
+		"inconsistent pad":    seal(t, append(bytes.Repeat([]byte{'x'}, 46), 1, 2)),
+		"length overflow":     seal(t, padded(frame(100, "hello", testReceiveId), 21)),
+		"missing length":      seal(t, padded([]byte("0123456789abcde"), 17)),
+		"tampered last block": "ZA4HuQypHxnRrA+A6SOHGL4m235ZCN1+Jv+aZ2kyu/rN3XE1k18dKRFj4YPZDobZHzL5ah1o0U98CpJT0i4GWA==",
+	}
+	for name, encrypt := range tests {
+		if _, err := crypt.Decrypt(encrypt); !errors.Is(err, ErrInvalidCiphertext) {
+			t.Errorf("%s: err = %v", name, err)
+		}
+	}
+	if msg, err := crypt.Decrypt(seal(t, padded(valid, 21))); err != nil || string(msg) != "hello" {
+		t.Errorf("valid frame = %q, %v", msg, err)
+	}
+}
+
+func TestNew_InvalidAESKey(t *testing.T) {
+	for _, key := range []string{"", "short", testEncodingAESKey + "A", "!WmYm7qr5nMoAUwZRjGtBxmz3KA1tkAj3ykkR6q2B2C"} {
+		if _, err := New(testToken, key, ""); !errors.Is(err, ErrInvalidAESKey) {
+			t.Errorf("New(%q) err = %v", key, err)
+		}
+	}
+	if _, err := (&MsgCrypt{}).EncryptWithRandom([]byte("short"), nil); err == nil {
+		t.Error("short random accepted")
+	}
+}